@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Autoscaler is implemented by the namespaced PersistentVolumeClaimAutoscaler
+// and the cluster-scoped ClusterPersistentVolumeClaimAutoscaler, which share
+// their volume policies and status.
+type Autoscaler interface {
+	client.Object
+
+	// GetVolumePolicies returns the volume policies of the autoscaler.
+	GetVolumePolicies() []VolumePolicy
+
+	// GetAutoscalerStatus returns a pointer to the status of the autoscaler.
+	GetAutoscalerStatus() *PersistentVolumeClaimAutoscalerStatus
+
+	// IsSuspended returns whether resizing is suspended for the autoscaler.
+	IsSuspended() bool
+
+	// GetAutoscalerName returns the name of the pvc-autoscaler instance,
+	// which reconciles the autoscaler.
+	GetAutoscalerName() string
+}
+
+var (
+	_ Autoscaler = &PersistentVolumeClaimAutoscaler{}
+	_ Autoscaler = &ClusterPersistentVolumeClaimAutoscaler{}
+)
+
+// GetVolumePolicies implements the [Autoscaler] interface.
+func (obj *PersistentVolumeClaimAutoscaler) GetVolumePolicies() []VolumePolicy {
+	return obj.Spec.VolumePolicies
+}
+
+// GetAutoscalerStatus implements the [Autoscaler] interface.
+func (obj *PersistentVolumeClaimAutoscaler) GetAutoscalerStatus() *PersistentVolumeClaimAutoscalerStatus {
+	return &obj.Status
+}
+
+// IsSuspended implements the [Autoscaler] interface.
+func (obj *PersistentVolumeClaimAutoscaler) IsSuspended() bool {
+	return obj.Spec.Suspend
+}
+
+// GetAutoscalerName implements the [Autoscaler] interface.
+func (obj *PersistentVolumeClaimAutoscaler) GetAutoscalerName() string {
+	return obj.Spec.AutoscalerName
+}
+
+// GetVolumePolicies implements the [Autoscaler] interface.
+func (obj *ClusterPersistentVolumeClaimAutoscaler) GetVolumePolicies() []VolumePolicy {
+	return obj.Spec.VolumePolicies
+}
+
+// GetAutoscalerStatus implements the [Autoscaler] interface.
+func (obj *ClusterPersistentVolumeClaimAutoscaler) GetAutoscalerStatus() *PersistentVolumeClaimAutoscalerStatus {
+	return &obj.Status
+}
+
+// IsSuspended implements the [Autoscaler] interface.
+func (obj *ClusterPersistentVolumeClaimAutoscaler) IsSuspended() bool {
+	return obj.Spec.Suspend
+}
+
+// GetAutoscalerName implements the [Autoscaler] interface.
+func (obj *ClusterPersistentVolumeClaimAutoscaler) GetAutoscalerName() string {
+	return obj.Spec.AutoscalerName
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=cpvca
+// +kubebuilder:printcolumn:name="AutoscalerName",type=string,JSONPath=`.spec.autoscalerName`
+// +kubebuilder:printcolumn:name="Suspended",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="Paused",type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`
+// +kubebuilder:printcolumn:name="Managed",type=integer,JSONPath=`.status.managedPVCs`
+// +kubebuilder:printcolumn:name="Healthy",type=integer,JSONPath=`.status.healthyPVCs`
+// +kubebuilder:printcolumn:name="Resizing",type=integer,JSONPath=`.status.resizingPVCs`
+// +kubebuilder:printcolumn:name="Max Utilization",type=integer,JSONPath=`.status.maxUtilizationPercent`
+// +kubebuilder:printcolumn:name="Last Reconcile",type=date,JSONPath=`.status.lastReconcileTime`,priority=1
+// +kubebuilder:printcolumn:name="Next Check",type=date,JSONPath=`.status.nextCheckTime`,priority=1
+
+// ClusterPersistentVolumeClaimAutoscaler is the Schema for the
+// clusterpersistentvolumeclaimautoscalers API
+type ClusterPersistentVolumeClaimAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`            // nolint:revive
+	metav1.ObjectMeta `json:"metadata,omitempty"` // nolint:revive
+
+	Spec   ClusterPersistentVolumeClaimAutoscalerSpec `json:"spec,omitempty"`
+	Status PersistentVolumeClaimAutoscalerStatus      `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterPersistentVolumeClaimAutoscalerList contains a list of ClusterPersistentVolumeClaimAutoscaler
+type ClusterPersistentVolumeClaimAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"` // nolint:revive
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterPersistentVolumeClaimAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterPersistentVolumeClaimAutoscaler{}, &ClusterPersistentVolumeClaimAutoscalerList{})
+}
+
+// ClusterPersistentVolumeClaimAutoscalerSpec defines the desired state of the
+// ClusterPersistentVolumeClaimAutoscaler.
+type ClusterPersistentVolumeClaimAutoscalerSpec struct {
+	// AutoscalerName optionally assigns this autoscaler to a named autoscaler
+	// instance. It has the same semantics as the autoscalerName of a
+	// PersistentVolumeClaimAutoscaler. Defaults to "".
+	// +kubebuilder:default=""
+	// +optional
+	AutoscalerName string `json:"autoscalerName,omitempty"`
+
+	// NamespaceSelector specifies a label query over the namespaces whose PVCs
+	// are managed by the autoscaler. When not specified, all namespaces are
+	// selected.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Selector specifies a label query over the PVCs in the selected namespaces,
+	// which are managed by the autoscaler. When not specified, all PVCs in the
+	// selected namespaces are managed. PVCs which are also managed by a
+	// PersistentVolumeClaimAutoscaler are left to it.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// VolumePolicies defines a list of policies for autoscaling PVCs.
+	// +kubebuilder:validation:MinItems=1
+	VolumePolicies []VolumePolicy `json:"volumePolicies"`
+
+	// Suspend specifies whether resizing of the PVCs is suspended. It has the
+	// same semantics as the suspend field of a PersistentVolumeClaimAutoscaler.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
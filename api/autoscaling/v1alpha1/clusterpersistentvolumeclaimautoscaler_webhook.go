@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *ClusterPersistentVolumeClaimAutoscaler) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &ClusterPersistentVolumeClaimAutoscaler{}).
+		WithDefaulter(&ClusterPersistentVolumeClaimAutoscalerCustomDefaulter{}).
+		WithValidator(&ClusterPersistentVolumeClaimAutoscalerCustomValidator{Client: mgr.GetAPIReader()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-autoscaling-gardener-cloud-v1alpha1-clusterpersistentvolumeclaimautoscaler,mutating=true,failurePolicy=fail,sideEffects=None,groups=autoscaling.gardener.cloud,resources=clusterpersistentvolumeclaimautoscalers,verbs=create;update,versions=v1alpha1,name=mclusterpersistentvolumeclaimautoscaler.kb.io,admissionReviewVersions=v1
+
+// ClusterPersistentVolumeClaimAutoscalerCustomDefaulter sets the default values of
+// [ClusterPersistentVolumeClaimAutoscaler] resources. It applies the same defaults as
+// the CRD, so that they are set even when the CRD in the cluster is outdated.
+type ClusterPersistentVolumeClaimAutoscalerCustomDefaulter struct{}
+
+var _ admission.Defaulter[*ClusterPersistentVolumeClaimAutoscaler] = &ClusterPersistentVolumeClaimAutoscalerCustomDefaulter{}
+
+// Default implements [admission.Defaulter] so a webhook will be registered
+// for the type
+func (d *ClusterPersistentVolumeClaimAutoscalerCustomDefaulter) Default(ctx context.Context, obj *ClusterPersistentVolumeClaimAutoscaler) error {
+	SetDefaultsVolumePolicies(obj.Spec.VolumePolicies)
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-autoscaling-gardener-cloud-v1alpha1-clusterpersistentvolumeclaimautoscaler,mutating=false,failurePolicy=fail,sideEffects=None,groups=autoscaling.gardener.cloud,resources=clusterpersistentvolumeclaimautoscalers,verbs=create;update,versions=v1alpha1,name=vclusterpersistentvolumeclaimautoscaler.kb.io,admissionReviewVersions=v1
+
+// ClusterPersistentVolumeClaimAutoscalerCustomValidator validates
+// [ClusterPersistentVolumeClaimAutoscaler] resources.
+type ClusterPersistentVolumeClaimAutoscalerCustomValidator struct {
+	Client client.Reader
+}
+
+var _ admission.Validator[*ClusterPersistentVolumeClaimAutoscaler] = &ClusterPersistentVolumeClaimAutoscalerCustomValidator{}
+
+// ValidateCreate implements [admission.Validator] so a webhook will be
+// registered for the type
+func (v *ClusterPersistentVolumeClaimAutoscalerCustomValidator) ValidateCreate(ctx context.Context, obj *ClusterPersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	if err := validateClusterResourceSpec(obj); err != nil {
+		return nil, err
+	}
+
+	return storageClassWarnings(ctx, v.Client, obj.Spec.VolumePolicies)
+}
+
+// ValidateUpdate implements [admission.Validator] so a webhook will be
+// registered for the type
+func (v *ClusterPersistentVolumeClaimAutoscalerCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *ClusterPersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	if err := validateClusterResourceSpec(newObj); err != nil {
+		return nil, err
+	}
+
+	return storageClassWarnings(ctx, v.Client, newObj.Spec.VolumePolicies)
+}
+
+// ValidateDelete implements [admission.Validator] so a webhook will be
+// registered for the type
+func (v *ClusterPersistentVolumeClaimAutoscalerCustomValidator) ValidateDelete(ctx context.Context, obj *ClusterPersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterResourceSpec validates the resource spec
+func validateClusterResourceSpec(cpvca *ClusterPersistentVolumeClaimAutoscaler) error {
+	allErrs := make(field.ErrorList, 0)
+
+	if cpvca.Spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(cpvca.Spec.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, field.NewPath("spec", "namespaceSelector"))...)
+	}
+
+	if cpvca.Spec.Selector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(cpvca.Spec.Selector, metav1validation.LabelSelectorValidationOptions{}, field.NewPath("spec", "selector"))...)
+	}
+
+	allErrs = append(allErrs, validateVolumePolicies(cpvca.Spec.VolumePolicies)...)
+
+	return allErrs.ToAggregate()
+}
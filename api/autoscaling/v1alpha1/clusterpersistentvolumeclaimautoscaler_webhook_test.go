@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClusterPersistentVolumeClaimAutoscaler Webhook", func() {
+	var (
+		validator *ClusterPersistentVolumeClaimAutoscalerCustomValidator
+		obj       *ClusterPersistentVolumeClaimAutoscaler
+	)
+
+	BeforeEach(func() {
+		scheme := apimachineryruntime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		validator = &ClusterPersistentVolumeClaimAutoscalerCustomValidator{
+			Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		}
+
+		obj = &ClusterPersistentVolumeClaimAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "cpvca-1"},
+			Spec: ClusterPersistentVolumeClaimAutoscalerSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}},
+				VolumePolicies: []VolumePolicy{
+					{
+						Match:       Match{Name: "*"},
+						MaxCapacity: resource.MustParse("5Gi"),
+					},
+				},
+			},
+		}
+	})
+
+	It("should admit if all fields are valid", func() {
+		warnings, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should deny an invalid namespace selector", func() {
+		obj.Spec.NamespaceSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tenant", Operator: "Unknown"}},
+		}
+
+		_, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).To(MatchError(ContainSubstring("spec.namespaceSelector")))
+	})
+
+	It("should deny invalid volume policies", func() {
+		obj.Spec.VolumePolicies[0].MaxCapacity = resource.MustParse("0")
+
+		_, err := validator.ValidateUpdate(ctx, obj, obj)
+		Expect(err).To(MatchError(ContainSubstring("spec.volumePolicies[0].maxCapacity")))
+	})
+
+	It("should warn about missing storage classes", func() {
+		obj.Spec.VolumePolicies[0].Match.StorageClassName = "missing"
+
+		warnings, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("spec.volumePolicies[0].match.storageClassName: storage class missing does not exist"))
+	})
+})
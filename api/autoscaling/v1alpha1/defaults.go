@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// The scaling rule defaults below must be kept in sync with the
+// +kubebuilder:default markers of ScalingRules.
+const (
+	// DefaultThresholdPercent is the default utilization threshold, if not
+	// specified in the scaling rules.
+	DefaultThresholdPercent = 80
+
+	// DefaultStepPercent is the default increase-by value, if not specified
+	// in the scaling rules.
+	DefaultStepPercent = 10
+
+	// DefaultMinStepAbsolute is the default minimum absolute increase-by
+	// value, if not specified in the scaling rules.
+	DefaultMinStepAbsolute = "1Gi"
+
+	// DefaultApprovalExpiration is the default time for which an approval of
+	// a resize is valid with the Manual resize strategy, if not specified in
+	// the scaling rules.
+	DefaultApprovalExpiration = 24 * time.Hour
+)
+
+// SetDefaultsVolumePolicies sets the default values of the given volume
+// policies. The defaults match the structural defaults of the CRDs, so that
+// objects which have not been defaulted by the API server, e.g. because they
+// were created with an outdated CRD, can be evaluated safely.
+func SetDefaultsVolumePolicies(policies []VolumePolicy) {
+	for i := range policies {
+		SetDefaultsVolumePolicy(&policies[i])
+	}
+}
+
+// SetDefaultsVolumePolicy sets the default values of the given volume policy.
+func SetDefaultsVolumePolicy(policy *VolumePolicy) {
+	if policy.Match.Name == "" {
+		policy.Match.Name = "*"
+	}
+
+	if policy.ScaleUp == nil {
+		policy.ScaleUp = &ScalingRules{}
+	}
+	SetDefaultsScalingRules(policy.ScaleUp)
+}
+
+// SetDefaultsScalingRules sets the default values of the given scaling rules.
+func SetDefaultsScalingRules(rules *ScalingRules) {
+	if rules.UtilizationThresholdPercent == nil {
+		rules.UtilizationThresholdPercent = ptr.To(DefaultThresholdPercent)
+	}
+
+	if rules.StepPercent == nil {
+		rules.StepPercent = ptr.To(DefaultStepPercent)
+	}
+
+	if rules.MinStepAbsolute == nil {
+		rules.MinStepAbsolute = ptr.To(resource.MustParse(DefaultMinStepAbsolute))
+	}
+
+	if rules.ResizeStrategy == "" {
+		rules.ResizeStrategy = InPlaceVolumeResizeStrategy
+	}
+}
+
+// DefaultedVolumePolicies returns a copy of the given volume policies with
+// the default values set. The given policies are not modified.
+func DefaultedVolumePolicies(policies []VolumePolicy) []VolumePolicy {
+	if policies == nil {
+		return nil
+	}
+
+	defaulted := make([]VolumePolicy, len(policies))
+	for i := range policies {
+		policies[i].DeepCopyInto(&defaulted[i])
+	}
+	SetDefaultsVolumePolicies(defaulted)
+
+	return defaulted
+}
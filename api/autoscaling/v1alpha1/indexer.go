@@ -11,10 +11,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// AutoscalerNameIndexKey is the field index key used to filter PVCAs by their autoscalerName.
+// AutoscalerNameIndexKey is the field index key used to filter PVCAs and
+// cluster PVCAs by their autoscalerName.
 const AutoscalerNameIndexKey = ".spec.autoscalerName"
 
-// AddAutoscalerNameFieldIndexer adds an index for AutoscalerName to the given indexer.
+// AddAutoscalerNameFieldIndexer adds an index for AutoscalerName of
+// PersistentVolumeClaimAutoscalers and ClusterPersistentVolumeClaimAutoscalers
+// to the given indexer.
 func AddAutoscalerNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &PersistentVolumeClaimAutoscaler{}, AutoscalerNameIndexKey, func(obj client.Object) []string {
 		pvca, ok := obj.(*PersistentVolumeClaimAutoscaler)
@@ -27,5 +30,16 @@ func AddAutoscalerNameFieldIndexer(ctx context.Context, indexer client.FieldInde
 		return fmt.Errorf("failed to add indexer for %s to PersistentVolumeClaimAutoscaler Informer: %w", AutoscalerNameIndexKey, err)
 	}
 
+	if err := indexer.IndexField(ctx, &ClusterPersistentVolumeClaimAutoscaler{}, AutoscalerNameIndexKey, func(obj client.Object) []string {
+		cpvca, ok := obj.(*ClusterPersistentVolumeClaimAutoscaler)
+		if !ok {
+			return nil
+		}
+
+		return []string{cpvca.Spec.AutoscalerName}
+	}); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to ClusterPersistentVolumeClaimAutoscaler Informer: %w", AutoscalerNameIndexKey, err)
+	}
+
 	return nil
 }
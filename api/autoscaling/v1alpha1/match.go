@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+// Matches returns whether the given PVC matches all criteria of the [Match].
+// The volumeClaimTemplate is the name of the StatefulSet volumeClaimTemplate,
+// from which the PVC has been created, or empty.
+func (m *Match) Matches(pvc *corev1.PersistentVolumeClaim, volumeClaimTemplate string) (bool, error) {
+	matched, err := path.Match(m.Name, pvc.Name)
+	if err != nil {
+		return false, fmt.Errorf("invalid volume policy name %q: %w", m.Name, err)
+	}
+	if !matched {
+		return false, nil
+	}
+
+	if m.VolumeClaimTemplate != "" && m.VolumeClaimTemplate != volumeClaimTemplate {
+		return false, nil
+	}
+
+	if m.StorageClassName != "" && m.StorageClassName != ptr.Deref(pvc.Spec.StorageClassName, "") {
+		return false, nil
+	}
+
+	if m.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(m.Selector)
+		if err != nil {
+			return false, fmt.Errorf("invalid volume policy selector: %w", err)
+		}
+		if !selector.Matches(labels.Set(pvc.Labels)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
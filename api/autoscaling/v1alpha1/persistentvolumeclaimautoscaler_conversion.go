@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// Hub marks this type as a conversion hub. All other versions of the
+// PersistentVolumeClaimAutoscaler are converted to and from v1alpha1, which
+// is the storage version.
+func (*PersistentVolumeClaimAutoscaler) Hub() {}
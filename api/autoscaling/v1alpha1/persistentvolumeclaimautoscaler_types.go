@@ -6,19 +6,30 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName=pvca
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="AutoscalerName",type=string,JSONPath=`.spec.autoscalerName`
 // +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetRef.name`
+// +kubebuilder:printcolumn:name="Suspended",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="Paused",type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`
+// +kubebuilder:printcolumn:name="Managed",type=integer,JSONPath=`.status.managedPVCs`
+// +kubebuilder:printcolumn:name="Healthy",type=integer,JSONPath=`.status.healthyPVCs`
+// +kubebuilder:printcolumn:name="Resizing",type=integer,JSONPath=`.status.resizingPVCs`
+// +kubebuilder:printcolumn:name="Max Utilization",type=integer,JSONPath=`.status.maxUtilizationPercent`
+// +kubebuilder:printcolumn:name="Last Reconcile",type=date,JSONPath=`.status.lastReconcileTime`,priority=1
+// +kubebuilder:printcolumn:name="Next Check",type=date,JSONPath=`.status.nextCheckTime`,priority=1
 
 // PersistentVolumeClaimAutoscaler is the Schema for the
 // persistentvolumeclaimautoscalers API
@@ -55,12 +66,55 @@ type PersistentVolumeClaimAutoscalerSpec struct {
 	AutoscalerName string `json:"autoscalerName,omitempty"`
 
 	// TargetRef specifies the reference to the workload controller (e.g., StatefulSet)
+	// whose PVCs will be managed by the autoscaler. Exactly one of targetRef,
+	// targetRefs and targetSelector must be specified.
+	// +optional
+	TargetRef autoscalingv1.CrossVersionObjectReference `json:"targetRef,omitzero"`
+
+	// TargetRefs specifies the references to multiple workload controllers,
 	// whose PVCs will be managed by the autoscaler.
-	TargetRef autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	// +optional
+	TargetRefs []autoscalingv1.CrossVersionObjectReference `json:"targetRefs,omitempty"`
+
+	// TargetSelector selects the workload controllers of one kind in the
+	// namespace of the autoscaler by their labels, whose PVCs will be managed
+	// by the autoscaler.
+	// +optional
+	TargetSelector *TargetSelector `json:"targetSelector,omitempty"`
 
 	// VolumePolicies defines a list of policies for autoscaling PVCs.
 	// +kubebuilder:validation:MinItems=1
 	VolumePolicies []VolumePolicy `json:"volumePolicies"`
+
+	// Suspend specifies whether resizing of the PVCs is suspended. While the
+	// autoscaler is suspended, metrics are still collected and recommendations
+	// are still provided, but the PVCs are never patched. Defaults to false.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// CheckInterval specifies how often the PVCs of the autoscaler are
+	// checked. Defaults to the interval configured via the --interval flag
+	// of the pvc-autoscaler. It must be at least 10s.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="must be >= 10s"
+	// +optional
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+}
+
+// MinCheckInterval is the minimum check interval of a
+// PersistentVolumeClaimAutoscaler. Shorter intervals would check the PVCs
+// more often than the metrics of the volumes are updated.
+const MinCheckInterval = 10 * time.Second
+
+// TargetSelector selects workload controllers of one kind by their labels.
+type TargetSelector struct {
+	// APIVersion is the API version of the selected workload controllers.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the selected workload controllers.
+	Kind string `json:"kind"`
+
+	// Selector is a label query over the workload controllers.
+	Selector metav1.LabelSelector `json:"selector"`
 }
 
 // PersistentVolumeClaimAutoscalerStatus defines the observed state of
@@ -79,6 +133,39 @@ type PersistentVolumeClaimAutoscalerStatus struct {
 	// TODO (RadaBDimitrova): remove this after pvc-autoscaler v0.3.0 has been released.
 	NextCheck metav1.Time `json:"nextCheck,omitempty"`
 
+	// ObservedGeneration is the most recent generation of the autoscaler,
+	// which has been observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastReconcileTime is the time at which the PVCs of the autoscaler have
+	// last been reconciled successfully.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// NextCheckTime is the time at which the PVCs of the autoscaler are
+	// scheduled to be checked next.
+	// +optional
+	NextCheckTime *metav1.Time `json:"nextCheckTime,omitempty"`
+
+	// ManagedPVCs is the number of PVCs managed by the autoscaler.
+	// +optional
+	ManagedPVCs int `json:"managedPVCs,omitempty"`
+
+	// HealthyPVCs is the number of managed PVCs, for which a recommendation
+	// is available and whose resizing state is known.
+	// +optional
+	HealthyPVCs int `json:"healthyPVCs,omitempty"`
+
+	// ResizingPVCs is the number of managed PVCs, which are being resized.
+	// +optional
+	ResizingPVCs int `json:"resizingPVCs,omitempty"`
+
+	// MaxUtilizationPercent is the highest used space or inodes percentage
+	// across the managed PVCs.
+	// +optional
+	MaxUtilizationPercent *int `json:"maxUtilizationPercent,omitempty"`
+
 	// VolumeRecommendations specifies the status and recommendations for the PVCs managed by the autoscaler.
 	VolumeRecommendations []VolumeRecommendation `json:"volumeRecommendations,omitempty"`
 
@@ -98,13 +185,26 @@ type VolumePolicy struct {
 	// [k8s.io/apimachinery/pkg/api/resource.Quantity] value.
 	MaxCapacity resource.Quantity `json:"maxCapacity"`
 
+	// MaxMonthlyCost specifies the maximum monthly cost up to which a PVC is
+	// allowed to be extended. The cost is calculated from the price per
+	// GiB-month configured on the StorageClass of the PVC. It is ignored for
+	// PVCs whose StorageClass does not specify a price.
+	// +optional
+	MaxMonthlyCost *resource.Quantity `json:"maxMonthlyCost,omitempty"`
+
 	// ScaleUp defines the rules for scaling up the PVC.
 	// +kubebuilder:default:={}
 	// +optional
 	ScaleUp *ScalingRules `json:"scaleUp,omitempty"`
+
+	// UniformScaling specifies whether all PVCs of the target matched by this policy should
+	// be kept at the same size. When one of them is resized, the remaining ones are resized
+	// up to the largest recommended size of the group, regardless of their own utilization.
+	// +optional
+	UniformScaling bool `json:"uniformScaling,omitempty"`
 }
 
-// Match defines the matching criteria for selecting PVCs to which a VolumePolicy applies. It supports exact name matching, glob pattern matching, and a default match-all option.
+// Match defines the matching criteria for selecting PVCs to which a VolumePolicy applies. It supports exact name matching, glob pattern matching, label selectors, and a default match-all option.
 type Match struct {
 	// Name specifies the name of the PVC.
 	// It supports exact and glob pattern matching (e.g., "data-*" matches "data-pvc").
@@ -114,6 +214,24 @@ type Match struct {
 	// +kubebuilder:validation:MinLength=1
 	// +optional
 	Name string `json:"name,omitempty"`
+
+	// Selector specifies a label query over the PVCs. When both Name and
+	// Selector are specified, a PVC has to match both of them.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// StorageClassName specifies the name of the StorageClass of the PVC. When
+	// specified together with other match criteria, a PVC has to match all of
+	// them.
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// VolumeClaimTemplate specifies the name of the StatefulSet volumeClaimTemplate
+	// from which the PVC has been created. It can only match PVCs when the targetRef
+	// is a StatefulSet. When specified together with Name or Selector, a PVC has to
+	// match all of them.
+	// +optional
+	VolumeClaimTemplate string `json:"volumeClaimTemplate,omitempty"`
 }
 
 // ScalingRules defines the rules for scaling a PVC.
@@ -126,6 +244,15 @@ type ScalingRules struct {
 	// +optional
 	UtilizationThresholdPercent *int `json:"utilizationThresholdPercent,omitempty"`
 
+	// CriticalUtilizationPercent specifies an emergency threshold percentage for used space and inodes.
+	// When the used space or inodes passes this threshold, the PVC is resized even if the
+	// cooldown duration has not elapsed yet. MaxCapacity is still respected.
+	// Must be greater than UtilizationThresholdPercent.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	CriticalUtilizationPercent *int `json:"criticalUtilizationPercent,omitempty"`
+
 	// StepPercent specifies the percentage by which to change the PVC storage capacity when scaling.
 	// +kubebuilder:validation:Minimum=5
 	// +kubebuilder:validation:Maximum=100
@@ -144,11 +271,25 @@ type ScalingRules struct {
 	// +optional
 	CooldownDuration *metav1.Duration `json:"cooldownDuration,omitempty"`
 
+	// StabilizationWindow specifies how long the used space or inodes must continuously
+	// stay above the utilization threshold before the targeted PVC objects are resized.
+	// Transient spikes which do not outlast the window do not trigger a resize. Passing the
+	// critical utilization threshold skips the window. When not set, a single sample above
+	// the threshold is enough to trigger a resize.
+	// +optional
+	StabilizationWindow *metav1.Duration `json:"stabilizationWindow,omitempty"`
+
 	// ResizeStrategy defines the strategy that will be used to resize the targeted PVC objects.
 	// +kubebuilder:default:=InPlace
-	// +kubebuilder:validation:Enum=InPlace;Off
+	// +kubebuilder:validation:Enum=InPlace;Off;Manual
 	// +optional
 	ResizeStrategy VolumeResizeStrategy `json:"resizeStrategy,omitempty"`
+
+	// ApprovalExpiration specifies how long an approval of a resize is valid
+	// with the Manual resize strategy, counted from the time of the approval.
+	// Defaults to 24h.
+	// +optional
+	ApprovalExpiration *metav1.Duration `json:"approvalExpiration,omitempty"`
 }
 
 // VolumeResizeStrategy is a string enumeration type that enumerates all possible resize strategies
@@ -160,6 +301,9 @@ const (
 	InPlaceVolumeResizeStrategy VolumeResizeStrategy = "InPlace"
 	// OffVolumeResizeStrategy turns off resizing.
 	OffVolumeResizeStrategy VolumeResizeStrategy = "Off"
+	// ManualVolumeResizeStrategy resizes the volume by directly modifying the corresponding
+	// PVC, once the recommended size has been approved.
+	ManualVolumeResizeStrategy VolumeResizeStrategy = "Manual"
 )
 
 // VolumeRecommendation defines the observed state of a PVC managed by the autoscaler.
@@ -167,18 +311,137 @@ type VolumeRecommendation struct {
 	// Name specifies the name of the PVC.
 	Name string `json:"name"`
 
+	// Namespace specifies the namespace of the PVC. It is only set by a
+	// ClusterPersistentVolumeClaimAutoscaler.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// UID specifies the UID of the PVC. It distinguishes the PVC from an
+	// earlier PVC with the same name, e.g. the PVC of a generic ephemeral
+	// volume, which is recreated together with its pod.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+
 	// Current specifies the current status of the PVC.
 	Current CurrentVolumeStatus `json:"current,omitempty"`
 
 	// Target specifies the target recommendations for the PVC.
 	Target TargetRecommendation `json:"target,omitempty"`
 
+	// VolumePolicyIndex specifies the index of the volume policy in
+	// .spec.volumePolicies, which applies to the PVC.
+	// +optional
+	VolumePolicyIndex *int `json:"volumePolicyIndex,omitempty"`
+
+	// Source specifies the autoscaler whose volume policies apply to the PVC,
+	// in the form <kind>/<name>. When a PVC is selected by multiple autoscalers,
+	// a PersistentVolumeClaimAutoscaler takes precedence over a
+	// ClusterPersistentVolumeClaimAutoscaler, and among autoscalers of the
+	// same kind the oldest one, or the first one by name, is used.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// TargetRef specifies the workload controller of a
+	// PersistentVolumeClaimAutoscaler, whose PVCs include the PVC.
+	// +optional
+	TargetRef *autoscalingv1.CrossVersionObjectReference `json:"targetRef,omitempty"`
+
 	// LastResizeTime specifies the timestamp when the last resize operation
 	// was initiated for this PVC. Used for cooldown calculation.
 	// +optional
 	LastResizeTime *metav1.Time `json:"lastResizeTime,omitempty"`
+
+	// ThresholdBreachStartTime specifies the timestamp since which the used space or inodes
+	// of the PVC have continuously been above the utilization threshold. Used for the
+	// stabilization window calculation.
+	// +optional
+	ThresholdBreachStartTime *metav1.Time `json:"thresholdBreachStartTime,omitempty"`
+
+	// Conditions specifies the status conditions of the PVC. They have the
+	// same types as the conditions of the autoscaler, which summarize the
+	// conditions of all of its PVCs.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ResizeHistory specifies the most recent resize operations of the PVC,
+	// oldest first. Only the last ResizeHistoryLimit operations are kept.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	ResizeHistory []ResizeHistoryEntry `json:"resizeHistory,omitempty"`
+}
+
+// ResizeHistoryLimit is the maximum number of entries kept in the resize
+// history of a PVC.
+const ResizeHistoryLimit = 10
+
+// ResizeHistoryEntry records a resize operation of a PVC.
+type ResizeHistoryEntry struct {
+	// Time specifies when the resize operation was initiated.
+	Time metav1.Time `json:"time"`
+
+	// From specifies the requested size of the PVC before the resize.
+	From resource.Quantity `json:"from"`
+
+	// To specifies the requested size of the PVC after the resize.
+	To resource.Quantity `json:"to"`
+
+	// Trigger specifies what caused the resize.
+	Trigger ResizeTrigger `json:"trigger"`
+
+	// UsedSpacePercent specifies the used space of the PVC as a percentage
+	// at the time of the resize.
+	// +optional
+	UsedSpacePercent *int `json:"usedSpacePercent,omitempty"`
+
+	// UsedInodesPercent specifies the used inodes of the PVC as a percentage
+	// at the time of the resize.
+	// +optional
+	UsedInodesPercent *int `json:"usedInodesPercent,omitempty"`
+
+	// Outcome specifies the result of the resize operation.
+	Outcome ResizeOutcome `json:"outcome"`
+
+	// Message specifies details about the resize operation, e.g. why the
+	// requested size has been capped.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+// ResizeTrigger is a string enumeration type that enumerates the causes of a
+// resize operation.
+// +kubebuilder:validation:Enum=Space;Inodes;Predictive;Manual;UniformScaling
+type ResizeTrigger string
+
+const (
+	// ResizeTriggerSpace indicates that the used space exceeded the utilization threshold.
+	ResizeTriggerSpace ResizeTrigger = "Space"
+	// ResizeTriggerInodes indicates that the used inodes exceeded the utilization threshold.
+	ResizeTriggerInodes ResizeTrigger = "Inodes"
+	// ResizeTriggerPredictive indicates that the utilization was predicted to exceed the
+	// utilization threshold.
+	ResizeTriggerPredictive ResizeTrigger = "Predictive"
+	// ResizeTriggerManual indicates that the resize has been requested manually.
+	ResizeTriggerManual ResizeTrigger = "Manual"
+	// ResizeTriggerUniformScaling indicates that the PVC has been resized to match the largest
+	// PVC of a volume policy with uniform scaling enabled.
+	ResizeTriggerUniformScaling ResizeTrigger = "UniformScaling"
+)
+
+// ResizeOutcome is a string enumeration type that enumerates the results of a
+// resize operation.
+// +kubebuilder:validation:Enum=InProgress;Succeeded;Failed
+type ResizeOutcome string
+
+const (
+	// ResizeOutcomeInProgress indicates that the PVC has been patched and the resize has not completed yet.
+	ResizeOutcomeInProgress ResizeOutcome = "InProgress"
+	// ResizeOutcomeSucceeded indicates that the capacity of the PVC has reached the requested size.
+	ResizeOutcomeSucceeded ResizeOutcome = "Succeeded"
+	// ResizeOutcomeFailed indicates that the PVC could not be patched, or that the resize
+	// completed without the capacity reaching the requested size.
+	ResizeOutcomeFailed ResizeOutcome = "Failed"
+)
+
 // CurrentVolumeStatus defines the current status of a PVC managed by the autoscaler.
 type CurrentVolumeStatus struct {
 	// UsedSpacePercent specifies the last observed used space of the PVC
@@ -194,6 +457,11 @@ type CurrentVolumeStatus struct {
 	// Size specifies the current .status.capacity.storage value of the PVC.
 	// +optional
 	Size *resource.Quantity `json:"size,omitempty"`
+
+	// MonthlyCost specifies the monthly cost of the current size of the PVC,
+	// based on the price per GiB-month configured on its StorageClass.
+	// +optional
+	MonthlyCost *resource.Quantity `json:"monthlyCost,omitempty"`
 }
 
 // TargetRecommendation defines the target recommendations for a PVC managed by the autoscaler.
@@ -201,6 +469,11 @@ type TargetRecommendation struct {
 	// Size specifies the new size to which the PVC will be resized.
 	// +optional
 	Size *resource.Quantity `json:"size,omitempty"`
+
+	// MonthlyCost specifies the projected monthly cost of the target size of
+	// the PVC, based on the price per GiB-month configured on its StorageClass.
+	// +optional
+	MonthlyCost *resource.Quantity `json:"monthlyCost,omitempty"`
 }
 
 // PersistentVolumeClaimAutoscalerConditionType are the valid conditions of
@@ -214,6 +487,16 @@ const (
 	// ConditionTypeResizing represents the type of condition indicating the
 	// status of the resize operation.
 	ConditionTypeResizing PersistentVolumeClaimAutoscalerConditionType = "Resizing"
+	// ConditionTypeSuspended represents the type of condition indicating
+	// that resizing is suspended for all PVCs of the autoscaler.
+	ConditionTypeSuspended PersistentVolumeClaimAutoscalerConditionType = "Suspended"
+	// ConditionTypePaused represents the type of condition indicating that
+	// resizing is paused for some PVCs of the autoscaler.
+	ConditionTypePaused PersistentVolumeClaimAutoscalerConditionType = "Paused"
+	// ConditionTypeOverlapping represents the type of condition indicating
+	// that some PVCs selected by the autoscaler are managed by another
+	// autoscaler, which takes precedence.
+	ConditionTypeOverlapping PersistentVolumeClaimAutoscalerConditionType = "Overlapping"
 )
 
 // SetCondition sets the given [metav1.Condition] for the object.
@@ -238,3 +521,14 @@ func (obj *PersistentVolumeClaimAutoscaler) RemoveCondition(ctx context.Context,
 
 	return klient.Status().Patch(ctx, obj, patch)
 }
+
+// GetTargetRefs returns the workload controllers referenced by the targetRef
+// and the targetRefs of the object. The workload controllers selected by the
+// targetSelector are not included, as they need to be looked up.
+func (obj *PersistentVolumeClaimAutoscaler) GetTargetRefs() []autoscalingv1.CrossVersionObjectReference {
+	if obj.Spec.TargetRef == (autoscalingv1.CrossVersionObjectReference{}) {
+		return obj.Spec.TargetRefs
+	}
+
+	return append([]autoscalingv1.CrossVersionObjectReference{obj.Spec.TargetRef}, obj.Spec.TargetRefs...)
+}
@@ -6,74 +6,527 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	pathvalidation "k8s.io/apimachinery/pkg/api/validation/path"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-// SetupWebhookWithManager will setup the manager to manage the webhooks
-func (r *PersistentVolumeClaimAutoscaler) SetupWebhookWithManager(mgr ctrl.Manager) error {
+// SetupWebhookWithManager will setup the manager to manage the webhooks. The
+// given [TargetPVCFetcher] is used for looking up the PVCs of the targets in
+// order to return warnings about them. It may be nil.
+func (r *PersistentVolumeClaimAutoscaler) SetupWebhookWithManager(mgr ctrl.Manager, pvcFetcher TargetPVCFetcher) error {
 	return ctrl.NewWebhookManagedBy(mgr, &PersistentVolumeClaimAutoscaler{}).
-		WithValidator(r).
+		WithDefaulter(&PersistentVolumeClaimAutoscalerCustomDefaulter{}).
+		WithValidator(&PersistentVolumeClaimAutoscalerCustomValidator{
+			Client:     mgr.GetClient(),
+			APIReader:  mgr.GetAPIReader(),
+			RESTMapper: mgr.GetRESTMapper(),
+			PVCFetcher: pvcFetcher,
+		}).
 		Complete()
 }
 
+// TargetPVCFetcher fetches the PVCs managed by the targetRef of a
+// [PersistentVolumeClaimAutoscaler].
+type TargetPVCFetcher interface {
+	// Fetch returns all PVCs that are managed by the targetRef.
+	Fetch(ctx context.Context, pvca *PersistentVolumeClaimAutoscaler) ([]*corev1.PersistentVolumeClaim, error)
+
+	// FetchVolumeClaimTemplates returns a map of PVC names to the name of
+	// the StatefulSet volumeClaimTemplate they have been created from.
+	FetchVolumeClaimTemplates(ctx context.Context, pvca *PersistentVolumeClaimAutoscaler, pvcs []*corev1.PersistentVolumeClaim) (map[string]string, error)
+}
+
+// +kubebuilder:webhook:path=/mutate-autoscaling-gardener-cloud-v1alpha1-persistentvolumeclaimautoscaler,mutating=true,failurePolicy=fail,sideEffects=None,groups=autoscaling.gardener.cloud,resources=persistentvolumeclaimautoscalers,verbs=create;update,versions=v1alpha1,name=mpersistentvolumeclaimautoscaler.kb.io,admissionReviewVersions=v1
+
+// PersistentVolumeClaimAutoscalerCustomDefaulter sets the default values of
+// [PersistentVolumeClaimAutoscaler] resources. It applies the same defaults as
+// the CRD, so that they are set even when the CRD in the cluster is outdated.
+type PersistentVolumeClaimAutoscalerCustomDefaulter struct{}
+
+var _ admission.Defaulter[*PersistentVolumeClaimAutoscaler] = &PersistentVolumeClaimAutoscalerCustomDefaulter{}
+
+// Default implements [admission.Defaulter] so a webhook will be registered
+// for the type
+func (d *PersistentVolumeClaimAutoscalerCustomDefaulter) Default(ctx context.Context, obj *PersistentVolumeClaimAutoscaler) error {
+	SetDefaultsVolumePolicies(obj.Spec.VolumePolicies)
+
+	return nil
+}
+
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
 // +kubebuilder:webhook:path=/validate-autoscaling-gardener-cloud-v1alpha1-persistentvolumeclaimautoscaler,mutating=false,failurePolicy=fail,sideEffects=None,groups=autoscaling.gardener.cloud,resources=persistentvolumeclaimautoscalers,verbs=create;update;delete,versions=v1alpha1,name=vpersistentvolumeclaimautoscaler.kb.io,admissionReviewVersions=v1
 
-var _ admission.Validator[*PersistentVolumeClaimAutoscaler] = &PersistentVolumeClaimAutoscaler{}
+// PersistentVolumeClaimAutoscalerCustomValidator validates
+// [PersistentVolumeClaimAutoscaler] resources. The Client is used for looking
+// up objects referenced by the resource in order to return warnings about
+// them. Problems with referenced objects only result in warnings, because
+// the objects may be created or changed after the resource. The APIReader is
+// used for looking up targets, whose kinds are not cached. The checks which
+// require the APIReader, the RESTMapper or the PVCFetcher are skipped when
+// they are nil.
+type PersistentVolumeClaimAutoscalerCustomValidator struct {
+	Client     client.Reader
+	APIReader  client.Reader
+	RESTMapper meta.RESTMapper
+	PVCFetcher TargetPVCFetcher
+}
+
+var _ admission.Validator[*PersistentVolumeClaimAutoscaler] = &PersistentVolumeClaimAutoscalerCustomValidator{}
 
 // ValidateCreate implements [admission.Validator] so a webhook will be
 // registered for the type
-func (r *PersistentVolumeClaimAutoscaler) ValidateCreate(ctx context.Context, obj *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
-	return nil, validateResourceSpec(obj)
+func (v *PersistentVolumeClaimAutoscalerCustomValidator) ValidateCreate(ctx context.Context, obj *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	if err := validateResourceSpec(obj); err != nil {
+		return nil, err
+	}
+
+	return v.warnings(ctx, obj)
 }
 
 // ValidateUpdate implements [admission.Validator] so a webhook will be
 // registered for the type
-func (r *PersistentVolumeClaimAutoscaler) ValidateUpdate(ctx context.Context, oldObj, newObj *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
-	return nil, validateResourceSpec(newObj)
+func (v *PersistentVolumeClaimAutoscalerCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	if err := validateResourceSpec(newObj); err != nil {
+		return nil, err
+	}
+
+	return v.warnings(ctx, newObj)
 }
 
 // ValidateDelete implements [admission.Validator] so a webhook will be
 // registered for the type
-func (r *PersistentVolumeClaimAutoscaler) ValidateDelete(ctx context.Context, obj *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+func (v *PersistentVolumeClaimAutoscalerCustomValidator) ValidateDelete(ctx context.Context, obj *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
 	return nil, nil
 }
 
+// warnings returns warnings about the objects referenced by the given
+// [PersistentVolumeClaimAutoscaler].
+func (v *PersistentVolumeClaimAutoscalerCustomValidator) warnings(ctx context.Context, pvca *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	warnings, err := storageClassWarnings(ctx, v.Client, pvca.Spec.VolumePolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	overlapWarnings, err := overlappingTargetWarnings(ctx, v.Client, pvca)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, overlapWarnings...)
+
+	if v.APIReader == nil || v.RESTMapper == nil {
+		return warnings, nil
+	}
+
+	targetExists, targetWarnings, err := targetRefWarnings(ctx, v.APIReader, v.RESTMapper, pvca)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, targetWarnings...)
+
+	if !targetExists || v.PVCFetcher == nil {
+		return warnings, nil
+	}
+
+	pvcWarnings, err := targetPVCWarnings(ctx, v.Client, v.PVCFetcher, pvca)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(warnings, pvcWarnings...), nil
+}
+
+// overlappingTargetWarnings returns warnings for other
+// [PersistentVolumeClaimAutoscaler] resources in the same namespace, which
+// reference the same target. Targets selected by a targetSelector are not
+// taken into account.
+func overlappingTargetWarnings(ctx context.Context, reader client.Reader, pvca *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	var pvcaList PersistentVolumeClaimAutoscalerList
+	if err := reader.List(ctx, &pvcaList, client.InNamespace(pvca.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var warnings admission.Warnings
+	for _, target := range referencedTargets(pvca) {
+		for _, other := range pvcaList.Items {
+			if other.Name == pvca.Name || !slices.ContainsFunc(other.GetTargetRefs(), func(ref autoscalingv1.CrossVersionObjectReference) bool {
+				return isSameTarget(ref, target.ref)
+			}) {
+				continue
+			}
+
+			warnings = append(warnings, fmt.Sprintf(
+				"%s: %s %s is also targeted by PersistentVolumeClaimAutoscaler %s, only the oldest one manages its PVCs",
+				target.path,
+				target.ref.Kind,
+				target.ref.Name,
+				other.Name,
+			))
+		}
+	}
+
+	return warnings, nil
+}
+
+// referencedTarget is a target referenced by the targetRef or the targetRefs
+// of a [PersistentVolumeClaimAutoscaler] together with its field path.
+type referencedTarget struct {
+	path *field.Path
+	ref  autoscalingv1.CrossVersionObjectReference
+}
+
+// referencedTargets returns the targets referenced by the targetRef and the
+// targetRefs of the given [PersistentVolumeClaimAutoscaler].
+func referencedTargets(pvca *PersistentVolumeClaimAutoscaler) []referencedTarget {
+	var targets []referencedTarget
+	if pvca.Spec.TargetRef != (autoscalingv1.CrossVersionObjectReference{}) {
+		targets = append(targets, referencedTarget{path: field.NewPath("spec", "targetRef"), ref: pvca.Spec.TargetRef})
+	}
+
+	for i, ref := range pvca.Spec.TargetRefs {
+		targets = append(targets, referencedTarget{path: field.NewPath("spec", "targetRefs").Index(i), ref: ref})
+	}
+
+	return targets
+}
+
+// targetsPath returns the field path of the targets of the given
+// [PersistentVolumeClaimAutoscaler].
+func targetsPath(pvca *PersistentVolumeClaimAutoscaler) *field.Path {
+	switch {
+	case pvca.Spec.TargetSelector != nil:
+		return field.NewPath("spec", "targetSelector")
+	case len(pvca.Spec.TargetRefs) > 0:
+		return field.NewPath("spec", "targetRefs")
+	default:
+		return field.NewPath("spec", "targetRef")
+	}
+}
+
+// describeTargets returns a human-readable description of the targets of the
+// given [PersistentVolumeClaimAutoscaler] for warnings.
+func describeTargets(pvca *PersistentVolumeClaimAutoscaler) string {
+	if selector := pvca.Spec.TargetSelector; selector != nil {
+		return fmt.Sprintf("the %s selected by %s", selector.Kind, metav1.FormatLabelSelector(&selector.Selector))
+	}
+
+	descriptions := make([]string, 0, len(pvca.GetTargetRefs()))
+	for _, ref := range pvca.GetTargetRefs() {
+		descriptions = append(descriptions, ref.Kind+" "+ref.Name)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// isSameTarget returns whether the given references point to the same object.
+// The version of the references is ignored.
+func isSameTarget(a, b autoscalingv1.CrossVersionObjectReference) bool {
+	gvA, errA := schema.ParseGroupVersion(a.APIVersion)
+	gvB, errB := schema.ParseGroupVersion(b.APIVersion)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return gvA.Group == gvB.Group && a.Kind == b.Kind && a.Name == b.Name
+}
+
+// targetRefWarnings returns warnings when the kind of a target is not served
+// by the API server, or when a referenced target does not exist. It also
+// returns whether all targets exist.
+func targetRefWarnings(ctx context.Context, reader client.Reader, restMapper meta.RESTMapper, pvca *PersistentVolumeClaimAutoscaler) (bool, admission.Warnings, error) {
+	var (
+		allExist = true
+		warnings admission.Warnings
+	)
+
+	if selector := pvca.Spec.TargetSelector; selector != nil {
+		_, warning, err := targetMapping(restMapper, field.NewPath("spec", "targetSelector"), selector.APIVersion, selector.Kind)
+		if err != nil {
+			return false, nil, err
+		}
+		if warning != "" {
+			allExist = false
+			warnings = append(warnings, warning)
+		}
+	}
+
+	for _, target := range referencedTargets(pvca) {
+		exists, warning, err := targetWarning(ctx, reader, restMapper, pvca.Namespace, target)
+		if err != nil {
+			return false, nil, err
+		}
+		if !exists {
+			allExist = false
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return allExist, warnings, nil
+}
+
+// targetWarning returns a warning when the kind of the referenced target is
+// not served by the API server, or when the target does not exist. It also
+// returns whether the target exists.
+func targetWarning(ctx context.Context, reader client.Reader, restMapper meta.RESTMapper, namespace string, target referencedTarget) (bool, string, error) {
+	mapping, warning, err := targetMapping(restMapper, target.path, target.ref.APIVersion, target.ref.Kind)
+	if err != nil || warning != "" {
+		return false, warning, err
+	}
+
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(mapping.GroupVersionKind)
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: target.ref.Name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, fmt.Sprintf("%s: %s %s does not exist", target.path.Child("name"), target.ref.Kind, target.ref.Name), nil
+		}
+
+		// The autoscaler may not be allowed to read arbitrary kinds, which
+		// must not prevent the creation of the resource.
+		return false, fmt.Sprintf("%s: unable to look up %s %s: %s", target.path.Child("name"), target.ref.Kind, target.ref.Name, err), nil
+	}
+
+	return true, "", nil
+}
+
+// targetMapping returns the REST mapping of the given kind of a target, or a
+// warning when the kind is not served by the API server.
+func targetMapping(restMapper meta.RESTMapper, fldPath *field.Path, apiVersion, kind string) (*meta.RESTMapping, string, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Sprintf("%s: %s is invalid: %s", fldPath.Child("apiVersion"), apiVersion, err), nil
+	}
+
+	mapping, err := restMapper.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, fmt.Sprintf("%s: %s is not served by %s", fldPath.Child("kind"), kind, apiVersion), nil
+		}
+
+		return nil, "", err
+	}
+
+	return mapping, "", nil
+}
+
+// targetPVCWarnings returns warnings for the PVCs managed by the target. It
+// warns about volume policies, which do not match any PVC, max capacities
+// below the current size of a PVC and StorageClasses of PVCs, which do not
+// allow volume expansion.
+func targetPVCWarnings(ctx context.Context, reader client.Reader, pvcFetcher TargetPVCFetcher, pvca *PersistentVolumeClaimAutoscaler) (admission.Warnings, error) {
+	pvcs, err := pvcFetcher.Fetch(ctx, pvca)
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("%s: unable to fetch the PVCs of %s: %s", targetsPath(pvca), describeTargets(pvca), err)}, nil
+	}
+
+	volumeClaimTemplates, err := pvcFetcher.FetchVolumeClaimTemplates(ctx, pvca, pvcs)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(pvcs, func(a, b *corev1.PersistentVolumeClaim) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var (
+		warnings       admission.Warnings
+		policies       = DefaultedVolumePolicies(pvca.Spec.VolumePolicies)
+		matched        = make([]bool, len(policies))
+		storageClasses = make(map[string]string)
+	)
+	for _, pvc := range pvcs {
+		for i := range policies {
+			ok, err := policies[i].Match.Matches(pvc, volumeClaimTemplates[pvc.Name])
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			matched[i] = true
+			if size, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok && policies[i].MaxCapacity.Cmp(size) < 0 {
+				warnings = append(warnings, fmt.Sprintf(
+					"spec.volumePolicies[%d].maxCapacity: %s is below the current size %s of PVC %s",
+					i, policies[i].MaxCapacity.String(), size.String(), pvc.Name,
+				))
+			}
+			if scName := ptr.Deref(pvc.Spec.StorageClassName, ""); scName != "" && policies[i].Match.StorageClassName == "" {
+				if _, ok := storageClasses[scName]; !ok {
+					storageClasses[scName] = pvc.Name
+				}
+			}
+
+			break
+		}
+	}
+
+	for i := range policies {
+		if !matched[i] {
+			warnings = append(warnings, fmt.Sprintf("spec.volumePolicies[%d].match: does not match any PVC of %s", i, describeTargets(pvca)))
+		}
+	}
+
+	for _, scName := range slices.Sorted(maps.Keys(storageClasses)) {
+		var sc storagev1.StorageClass
+		if err := reader.Get(ctx, types.NamespacedName{Name: scName}, &sc); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if !ptr.Deref(sc.AllowVolumeExpansion, false) {
+			warnings = append(warnings, fmt.Sprintf("%s: storage class %s of PVC %s does not allow volume expansion", targetsPath(pvca), scName, storageClasses[scName]))
+		}
+	}
+
+	return warnings, nil
+}
+
+// storageClassWarnings returns warnings for StorageClasses referenced by the
+// volume policies, which do not exist or do not allow volume expansion.
+func storageClassWarnings(ctx context.Context, reader client.Reader, policies []VolumePolicy) (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	for i, policy := range policies {
+		scName := policy.Match.StorageClassName
+		if scName == "" {
+			continue
+		}
+
+		fieldPath := field.NewPath("spec", "volumePolicies").Index(i).Child("match", "storageClassName")
+		var sc storagev1.StorageClass
+		if err := reader.Get(ctx, types.NamespacedName{Name: scName}, &sc); err != nil {
+			if apierrors.IsNotFound(err) {
+				warnings = append(warnings, fmt.Sprintf("%s: storage class %s does not exist", fieldPath, scName))
+
+				continue
+			}
+
+			return nil, err
+		}
+
+		if !ptr.Deref(sc.AllowVolumeExpansion, false) {
+			warnings = append(warnings, fmt.Sprintf("%s: storage class %s does not allow volume expansion", fieldPath, scName))
+		}
+	}
+
+	return warnings, nil
+}
+
 // validateResourceSpec validates the resource spec
 func validateResourceSpec(pvca *PersistentVolumeClaimAutoscaler) error {
-	allErrs := make(field.ErrorList, 0)
+	allErrs := validateTargets(pvca)
+	allErrs = append(allErrs, validateVolumePolicies(pvca.Spec.VolumePolicies)...)
 
-	if len(pvca.Spec.TargetRef.Kind) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec.targetRef.kind"), ""))
-	} else {
-		for _, msg := range pathvalidation.IsValidPathSegmentName(pvca.Spec.TargetRef.Kind) {
-			allErrs = append(allErrs, field.Invalid(field.NewPath("spec.targetRef.kind"), pvca.Spec.TargetRef.Kind, msg))
+	if pvca.Spec.CheckInterval != nil && pvca.Spec.CheckInterval.Duration < MinCheckInterval {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "checkInterval"), pvca.Spec.CheckInterval.Duration.String(), "must be >= "+MinCheckInterval.String()))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateTargets validates that exactly one of the targetRef, the targetRefs
+// and the targetSelector is specified, and validates the specified one.
+func validateTargets(pvca *PersistentVolumeClaimAutoscaler) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		specPath  = field.NewPath("spec")
+		specified = 0
+	)
+
+	if pvca.Spec.TargetRef != (autoscalingv1.CrossVersionObjectReference{}) {
+		specified++
+	}
+	if len(pvca.Spec.TargetRefs) > 0 {
+		specified++
+	}
+	if pvca.Spec.TargetSelector != nil {
+		specified++
+	}
+
+	switch {
+	case specified == 0:
+		// The targetRef used to be the only way to specify the target, so
+		// its fields are reported as missing.
+		return validateTargetRef(specPath.Child("targetRef"), pvca.Spec.TargetRef)
+	case specified > 1:
+		return append(allErrs, field.Forbidden(specPath, "only one of targetRef, targetRefs and targetSelector may be specified"))
+	}
+
+	if pvca.Spec.TargetRef != (autoscalingv1.CrossVersionObjectReference{}) {
+		allErrs = append(allErrs, validateTargetRef(specPath.Child("targetRef"), pvca.Spec.TargetRef)...)
+	}
+
+	for i, targetRef := range pvca.Spec.TargetRefs {
+		allErrs = append(allErrs, validateTargetRef(specPath.Child("targetRefs").Index(i), targetRef)...)
+	}
+
+	if selector := pvca.Spec.TargetSelector; selector != nil {
+		selectorPath := specPath.Child("targetSelector")
+		allErrs = append(allErrs, validateTargetKind(selectorPath.Child("kind"), selector.Kind)...)
+		if len(selector.APIVersion) == 0 {
+			allErrs = append(allErrs, field.Required(selectorPath.Child("apiVersion"), ""))
 		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&selector.Selector, metav1validation.LabelSelectorValidationOptions{}, selectorPath.Child("selector"))...)
 	}
 
-	if len(pvca.Spec.TargetRef.Name) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec.targetRef.name"), ""))
+	return allErrs
+}
+
+// validateTargetRef validates a reference to a workload controller.
+func validateTargetRef(fldPath *field.Path, targetRef autoscalingv1.CrossVersionObjectReference) field.ErrorList {
+	allErrs := validateTargetKind(fldPath.Child("kind"), targetRef.Kind)
+
+	if len(targetRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
 	} else {
-		for _, msg := range pathvalidation.IsValidPathSegmentName(pvca.Spec.TargetRef.Name) {
-			allErrs = append(allErrs, field.Invalid(field.NewPath("spec.targetRef.name"), pvca.Spec.TargetRef.Name, msg))
+		for _, msg := range pathvalidation.IsValidPathSegmentName(targetRef.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), targetRef.Name, msg))
 		}
 	}
 
-	if len(pvca.Spec.TargetRef.APIVersion) == 0 {
-		e := field.Required(field.NewPath("spec.targetRef.apiVersion"), "")
-		allErrs = append(allErrs, e)
+	if len(targetRef.APIVersion) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiVersion"), ""))
 	}
 
-	allErrs = append(allErrs, validateVolumePolicies(pvca.Spec.VolumePolicies)...)
+	return allErrs
+}
 
-	return allErrs.ToAggregate()
+// validateTargetKind validates the kind of a workload controller.
+func validateTargetKind(fldPath *field.Path, kind string) field.ErrorList {
+	if len(kind) == 0 {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+
+	allErrs := field.ErrorList{}
+	for _, msg := range pathvalidation.IsValidPathSegmentName(kind) {
+		allErrs = append(allErrs, field.Invalid(fldPath, kind, msg))
+	}
+
+	return allErrs
 }
 
 // validateVolumePolicies validates the volume policies
@@ -90,10 +543,30 @@ func validateVolumePolicies(policies []VolumePolicy) field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(policyPath.Child("match", "name"), policy.Match.Name, msg))
 		}
 
+		if len(policy.Match.StorageClassName) > 0 {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(policy.Match.StorageClassName) {
+				allErrs = append(allErrs, field.Invalid(policyPath.Child("match", "storageClassName"), policy.Match.StorageClassName, msg))
+			}
+		}
+
+		if len(policy.Match.VolumeClaimTemplate) > 0 {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(policy.Match.VolumeClaimTemplate) {
+				allErrs = append(allErrs, field.Invalid(policyPath.Child("match", "volumeClaimTemplate"), policy.Match.VolumeClaimTemplate, msg))
+			}
+		}
+
+		if policy.Match.Selector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(policy.Match.Selector, metav1validation.LabelSelectorValidationOptions{}, policyPath.Child("match", "selector"))...)
+		}
+
 		if policy.MaxCapacity.Cmp(resource.Quantity{}) <= 0 {
 			allErrs = append(allErrs, field.Invalid(policyPath.Child("maxCapacity"), policy.MaxCapacity.String(), "must be > 0"))
 		}
 
+		if policy.MaxMonthlyCost != nil && policy.MaxMonthlyCost.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("maxMonthlyCost"), policy.MaxMonthlyCost.String(), "must be > 0"))
+		}
+
 		if policy.ScaleUp != nil && policy.ScaleUp.MinStepAbsolute != nil {
 			if policy.ScaleUp.MinStepAbsolute.Cmp(minStep) < 0 {
 				allErrs = append(allErrs, field.Invalid(policyPath.Child("scaleUp", "minStepAbsolute"), policy.ScaleUp.MinStepAbsolute.String(), "must be >= 1Gi"))
@@ -105,6 +578,24 @@ func validateVolumePolicies(policies []VolumePolicy) field.ErrorList {
 				allErrs = append(allErrs, field.Invalid(policyPath.Child("scaleUp", "cooldownDuration"), policy.ScaleUp.CooldownDuration.Duration.String(), "must be > 0s"))
 			}
 		}
+
+		if policy.ScaleUp != nil && policy.ScaleUp.StabilizationWindow != nil {
+			if policy.ScaleUp.StabilizationWindow.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(policyPath.Child("scaleUp", "stabilizationWindow"), policy.ScaleUp.StabilizationWindow.Duration.String(), "must be > 0s"))
+			}
+		}
+
+		if policy.ScaleUp != nil && policy.ScaleUp.ApprovalExpiration != nil {
+			if policy.ScaleUp.ApprovalExpiration.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(policyPath.Child("scaleUp", "approvalExpiration"), policy.ScaleUp.ApprovalExpiration.Duration.String(), "must be > 0s"))
+			}
+		}
+
+		if policy.ScaleUp != nil && policy.ScaleUp.CriticalUtilizationPercent != nil {
+			if *policy.ScaleUp.CriticalUtilizationPercent <= ptr.Deref(policy.ScaleUp.UtilizationThresholdPercent, DefaultThresholdPercent) {
+				allErrs = append(allErrs, field.Invalid(policyPath.Child("scaleUp", "criticalUtilizationPercent"), *policy.ScaleUp.CriticalUtilizationPercent, "must be > utilizationThresholdPercent"))
+			}
+		}
 	}
 
 	return allErrs
@@ -5,15 +5,23 @@
 package v1alpha1
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/gardener/pvc-autoscaler/internal/common"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
@@ -46,8 +54,47 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 			pvca := &PersistentVolumeClaimAutoscaler{}
 			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), pvca)).To(Succeed())
 			Expect(pvca.Spec.VolumePolicies).To(HaveLen(1))
-			Expect(pvca.Spec.VolumePolicies[0].ScaleUp.StepPercent).To(Equal(ptr.To(common.DefaultStepPercent)))
-			Expect(pvca.Spec.VolumePolicies[0].ScaleUp.UtilizationThresholdPercent).To(Equal(ptr.To(common.DefaultThresholdPercent)))
+			Expect(pvca.Spec.VolumePolicies[0].ScaleUp.StepPercent).To(Equal(ptr.To(DefaultStepPercent)))
+			Expect(pvca.Spec.VolumePolicies[0].ScaleUp.UtilizationThresholdPercent).To(Equal(ptr.To(DefaultThresholdPercent)))
+		})
+
+		It("should fill in default values when the CRD defaults were not applied", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					VolumePolicies: []VolumePolicy{
+						{MaxCapacity: resource.MustParse("5Gi")},
+						{
+							Match:       Match{Name: "data-*"},
+							MaxCapacity: resource.MustParse("5Gi"),
+							ScaleUp:     &ScalingRules{StepPercent: ptr.To(50)},
+						},
+					},
+				},
+			}
+
+			defaulter := &PersistentVolumeClaimAutoscalerCustomDefaulter{}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.VolumePolicies[0].Match.Name).To(Equal("*"))
+			Expect(obj.Spec.VolumePolicies[0].ScaleUp).To(Equal(&ScalingRules{
+				UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+				StepPercent:                 ptr.To(DefaultStepPercent),
+				MinStepAbsolute:             ptr.To(resource.MustParse(DefaultMinStepAbsolute)),
+				ResizeStrategy:              InPlaceVolumeResizeStrategy,
+			}))
+			Expect(obj.Spec.VolumePolicies[1].Match.Name).To(Equal("data-*"))
+			Expect(obj.Spec.VolumePolicies[1].ScaleUp.StepPercent).To(Equal(ptr.To(50)))
+			Expect(obj.Spec.VolumePolicies[1].ScaleUp.UtilizationThresholdPercent).To(Equal(ptr.To(DefaultThresholdPercent)))
+		})
+
+		It("should return defaulted copies of the volume policies", func() {
+			policies := []VolumePolicy{{MaxCapacity: resource.MustParse("5Gi")}}
+
+			defaulted := DefaultedVolumePolicies(policies)
+			Expect(defaulted).To(HaveLen(1))
+			Expect(defaulted[0].ScaleUp).NotTo(BeNil())
+			Expect(defaulted[0].ScaleUp.StepPercent).To(Equal(ptr.To(DefaultStepPercent)))
+			Expect(policies[0].ScaleUp).To(BeNil())
+			Expect(policies[0].Match.Name).To(BeEmpty())
 		})
 	})
 
@@ -68,8 +115,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 							}),
@@ -116,8 +163,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 							}),
@@ -145,8 +192,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 					VolumePolicies: []VolumePolicy{
 						{
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 							}),
 						},
 					},
@@ -172,7 +219,7 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
 								StepPercent:                 ptr.To(200),
 							}),
 						},
@@ -200,7 +247,7 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
 								UtilizationThresholdPercent: ptr.To(200),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 							}),
 						},
 					},
@@ -226,8 +273,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								MinStepAbsolute:             ptr.To(resource.MustParse("0.5Gi")),
 							}),
 						},
@@ -254,8 +301,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 0}),
 							}),
@@ -267,6 +314,300 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
 		})
 
+		It("should deny if invalid checkInterval is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-check-interval",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-check-interval",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity: resource.MustParse("5Gi"),
+						},
+					},
+					CheckInterval: ptr.To(metav1.Duration{Duration: -time.Second}),
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should deny if checkInterval is below the minimum", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-short-check-interval",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-short-check-interval",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity: resource.MustParse("5Gi"),
+						},
+					},
+					CheckInterval: ptr.To(metav1.Duration{Duration: 5 * time.Second}),
+				},
+			}
+
+			err := k8sClient.Create(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be >= 10s"))
+		})
+
+		It("should deny if invalid stabilizationWindow is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9d",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9d",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity: resource.MustParse("5Gi"),
+							ScaleUp: ptr.To(ScalingRules{
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
+								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
+								StabilizationWindow:         ptr.To(metav1.Duration{Duration: -1}),
+							}),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should deny if invalid approvalExpiration is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9i",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9i",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity: resource.MustParse("5Gi"),
+							ScaleUp: ptr.To(ScalingRules{
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
+								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
+								ResizeStrategy:              ManualVolumeResizeStrategy,
+								ApprovalExpiration:          ptr.To(metav1.Duration{Duration: 0}),
+							}),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should deny if invalid match selector is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9f",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9f",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							Match: Match{
+								Name: "*",
+								Selector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{Key: "tier", Operator: metav1.LabelSelectorOpIn},
+									},
+								},
+							},
+							MaxCapacity: resource.MustParse("5Gi"),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should deny if invalid match volumeClaimTemplate is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9h",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "sts-9h",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							Match: Match{
+								Name:                "*",
+								VolumeClaimTemplate: "Invalid_Template",
+							},
+							MaxCapacity: resource.MustParse("5Gi"),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should admit if valid match selector is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9g",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9g",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							Match: Match{
+								Name:     "*",
+								Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"volume-role": "wal"}},
+							},
+							MaxCapacity: resource.MustParse("5Gi"),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, obj)).To(Succeed())
+		})
+
+		It("should deny if invalid maxMonthlyCost is specified", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9e",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9e",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity:    resource.MustParse("5Gi"),
+							MaxMonthlyCost: ptr.To(resource.MustParse("0")),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should deny if criticalUtilizationPercent is not greater than utilizationThresholdPercent", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9b",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9b",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity: resource.MustParse("5Gi"),
+							ScaleUp: ptr.To(ScalingRules{
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								CriticalUtilizationPercent:  ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
+								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
+							}),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
+		})
+
+		It("should deny if criticalUtilizationPercent is not greater than the default utilizationThresholdPercent", func() {
+			policies := []VolumePolicy{
+				{
+					MaxCapacity: resource.MustParse("5Gi"),
+					ScaleUp: &ScalingRules{
+						CriticalUtilizationPercent: ptr.To(DefaultThresholdPercent),
+					},
+				},
+			}
+
+			Expect(validateVolumePolicies(policies)).To(ConsistOf(
+				HaveField("Field", "spec.volumePolicies[0].scaleUp.criticalUtilizationPercent"),
+			))
+		})
+
+		It("should admit if criticalUtilizationPercent is greater than utilizationThresholdPercent", func() {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-9c",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "PersistentVolumeClaim",
+						Name:       "pvc-9c",
+					},
+					VolumePolicies: []VolumePolicy{
+						{
+							MaxCapacity: resource.MustParse("5Gi"),
+							ScaleUp: ptr.To(ScalingRules{
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								CriticalUtilizationPercent:  ptr.To(95),
+								StepPercent:                 ptr.To(DefaultStepPercent),
+								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
+								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
+							}),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, obj)).To(Succeed())
+		})
+
 		It("should deny if match name contains unsupported characters", func() {
 			obj := &PersistentVolumeClaimAutoscaler{
 				ObjectMeta: metav1.ObjectMeta{
@@ -286,8 +627,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 							},
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 							}),
@@ -315,8 +656,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -344,8 +685,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -373,8 +714,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -403,8 +744,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -433,8 +774,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -462,8 +803,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -491,8 +832,8 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 						{
 							MaxCapacity: resource.MustParse("5Gi"),
 							ScaleUp: ptr.To(ScalingRules{
-								UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
-								StepPercent:                 ptr.To(common.DefaultStepPercent),
+								UtilizationThresholdPercent: ptr.To(DefaultThresholdPercent),
+								StepPercent:                 ptr.To(DefaultStepPercent),
 								CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
 								MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
 							}),
@@ -504,4 +845,298 @@ var _ = Describe("PersistentVolumeClaimAutoscaler Webhook", func() {
 			Expect(k8sClient.Create(ctx, obj)).NotTo(Succeed())
 		})
 	})
+
+	When("validating the StorageClasses referenced by the volume policies", func() {
+		var validator *PersistentVolumeClaimAutoscalerCustomValidator
+
+		newPVCA := func(storageClassNames ...string) *PersistentVolumeClaimAutoscaler {
+			obj := &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvca-sc",
+					Namespace: "default",
+				},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "sts",
+					},
+				},
+			}
+			for _, name := range storageClassNames {
+				obj.Spec.VolumePolicies = append(obj.Spec.VolumePolicies, VolumePolicy{
+					Match:       Match{Name: "*", StorageClassName: name},
+					MaxCapacity: resource.MustParse("5Gi"),
+				})
+			}
+
+			return obj
+		}
+
+		BeforeEach(func() {
+			scheme := apimachineryruntime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(AddToScheme(scheme)).To(Succeed())
+			validator = &PersistentVolumeClaimAutoscalerCustomValidator{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&storagev1.StorageClass{
+						ObjectMeta:           metav1.ObjectMeta{Name: "premium-ssd"},
+						Provisioner:          "example.com/ssd",
+						AllowVolumeExpansion: ptr.To(true),
+					},
+					&storagev1.StorageClass{
+						ObjectMeta:  metav1.ObjectMeta{Name: "standard-hdd"},
+						Provisioner: "example.com/hdd",
+					},
+				).Build(),
+			}
+		})
+
+		It("should not warn about an expandable storage class", func() {
+			warnings, err := validator.ValidateCreate(ctx, newPVCA("premium-ssd"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should warn about missing and non-expandable storage classes", func() {
+			warnings, err := validator.ValidateUpdate(ctx, newPVCA(), newPVCA("premium-ssd", "standard-hdd", "missing"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.volumePolicies[1].match.storageClassName: storage class standard-hdd does not allow volume expansion",
+				"spec.volumePolicies[2].match.storageClassName: storage class missing does not exist",
+			))
+		})
+
+		It("should deny an invalid storage class name", func() {
+			_, err := validator.ValidateCreate(ctx, newPVCA("Invalid_Name"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("validating the objects referenced by the targetRef", func() {
+		var (
+			validator  *PersistentVolumeClaimAutoscalerCustomValidator
+			pvcFetcher *fakeTargetPVCFetcher
+			pvca       *PersistentVolumeClaimAutoscaler
+		)
+
+		newPVC := func(name, storageClassName, size string) *corev1.PersistentVolumeClaim {
+			return &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: ptr.To(storageClassName)},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			scheme := apimachineryruntime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(AddToScheme(scheme)).To(Succeed())
+
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(appsv1.SchemeGroupVersion.WithKind("StatefulSet"), meta.RESTScopeNamespace)
+
+			pvca = &PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvca-target", Namespace: "default"},
+				Spec: PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "sts",
+					},
+					VolumePolicies: []VolumePolicy{
+						{Match: Match{Name: "data-*"}, MaxCapacity: resource.MustParse("5Gi")},
+						{Match: Match{Name: "logs-*"}, MaxCapacity: resource.MustParse("5Gi")},
+					},
+				},
+			}
+			pvcFetcher = &fakeTargetPVCFetcher{
+				pvcs: []*corev1.PersistentVolumeClaim{
+					newPVC("data-sts-0", "premium-ssd", "2Gi"),
+					newPVC("data-sts-1", "premium-ssd", "2Gi"),
+				},
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "default"}},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}},
+				&storagev1.StorageClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: "premium-ssd"},
+					Provisioner:          "example.com/ssd",
+					AllowVolumeExpansion: ptr.To(true),
+				},
+				&storagev1.StorageClass{
+					ObjectMeta:  metav1.ObjectMeta{Name: "standard-hdd"},
+					Provisioner: "example.com/hdd",
+				},
+			).Build()
+			validator = &PersistentVolumeClaimAutoscalerCustomValidator{
+				Client:     fakeClient,
+				APIReader:  fakeClient,
+				RESTMapper: restMapper,
+				PVCFetcher: pvcFetcher,
+			}
+		})
+
+		It("should warn about policies which do not match any PVC", func() {
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.volumePolicies[1].match: does not match any PVC of StatefulSet sts",
+			))
+		})
+
+		It("should warn about a max capacity below the current PVC size", func() {
+			pvcFetcher.pvcs = append(pvcFetcher.pvcs, newPVC("logs-sts-0", "premium-ssd", "10Gi"))
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.volumePolicies[1].maxCapacity: 5Gi is below the current size 10Gi of PVC logs-sts-0",
+			))
+		})
+
+		It("should warn about PVCs with a non-expandable storage class", func() {
+			pvcFetcher.pvcs = append(pvcFetcher.pvcs, newPVC("logs-sts-0", "standard-hdd", "1Gi"))
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.targetRef: storage class standard-hdd of PVC logs-sts-0 does not allow volume expansion",
+			))
+		})
+
+		It("should warn about a missing target", func() {
+			pvca.Spec.TargetRef.Name = "missing"
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spec.targetRef.name: StatefulSet missing does not exist"))
+		})
+
+		It("should warn about a target kind which is not served", func() {
+			pvca.Spec.TargetRef.Kind = "Unknown"
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spec.targetRef.kind: Unknown is not served by apps/v1"))
+		})
+
+		It("should warn about other PVCAs with the same target", func() {
+			other := pvca.DeepCopy()
+			other.Name = "pvca-other"
+			Expect(validator.Client.(client.Client).Create(ctx, other)).To(Succeed())
+			pvcFetcher.pvcs = append(pvcFetcher.pvcs, newPVC("logs-sts-0", "premium-ssd", "1Gi"))
+
+			warnings, err := validator.ValidateUpdate(ctx, pvca, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.targetRef: StatefulSet sts is also targeted by PersistentVolumeClaimAutoscaler pvca-other, only the oldest one manages its PVCs",
+			))
+		})
+
+		It("should deny if more than one of targetRef, targetRefs and targetSelector is specified", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{pvca.Spec.TargetRef}
+
+			_, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).To(MatchError(ContainSubstring("spec: Forbidden: only one of targetRef, targetRefs and targetSelector may be specified")))
+		})
+
+		It("should deny if a targetRefs entry is invalid", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{
+				pvca.Spec.TargetRef,
+				{APIVersion: "apps/v1", Kind: "StatefulSet"},
+			}
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+
+			_, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).To(MatchError(ContainSubstring("spec.targetRefs[1].name: Required value")))
+		})
+
+		It("should deny if the targetSelector is invalid", func() {
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+			pvca.Spec.TargetSelector = &TargetSelector{
+				Kind: "StatefulSet",
+				Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: "Invalid"},
+				}},
+			}
+
+			_, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).To(And(
+				MatchError(ContainSubstring("spec.targetSelector.apiVersion: Required value")),
+				MatchError(ContainSubstring("spec.targetSelector.selector.matchExpressions[0].operator")),
+			))
+		})
+
+		It("should warn about missing targets of the targetRefs", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{
+				pvca.Spec.TargetRef,
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "missing"},
+			}
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spec.targetRefs[1].name: StatefulSet missing does not exist"))
+		})
+
+		It("should describe all targets of the targetRefs", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{
+				pvca.Spec.TargetRef,
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "other"},
+			}
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.volumePolicies[1].match: does not match any PVC of StatefulSet sts, StatefulSet other",
+			))
+		})
+
+		It("should warn about a targetSelector kind which is not served", func() {
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+			pvca.Spec.TargetSelector = &TargetSelector{
+				APIVersion: "apps/v1",
+				Kind:       "Unknown",
+				Selector:   metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+			}
+
+			warnings, err := validator.ValidateCreate(ctx, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spec.targetSelector.kind: Unknown is not served by apps/v1"))
+		})
+
+		It("should warn about other PVCAs with one of the targetRefs", func() {
+			other := pvca.DeepCopy()
+			other.Name = "pvca-other"
+			Expect(validator.Client.(client.Client).Create(ctx, other)).To(Succeed())
+			pvcFetcher.pvcs = append(pvcFetcher.pvcs, newPVC("logs-sts-0", "premium-ssd", "1Gi"))
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "other"},
+				pvca.Spec.TargetRef,
+			}
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+
+			warnings, err := validator.ValidateUpdate(ctx, pvca, pvca)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spec.targetRefs[1]: StatefulSet sts is also targeted by PersistentVolumeClaimAutoscaler pvca-other, only the oldest one manages its PVCs",
+			))
+		})
+	})
 })
+
+type fakeTargetPVCFetcher struct {
+	pvcs []*corev1.PersistentVolumeClaim
+}
+
+func (f *fakeTargetPVCFetcher) Fetch(context.Context, *PersistentVolumeClaimAutoscaler) ([]*corev1.PersistentVolumeClaim, error) {
+	return f.pvcs, nil
+}
+
+func (f *fakeTargetPVCFetcher) FetchVolumeClaimTemplates(context.Context, *PersistentVolumeClaimAutoscaler, []*corev1.PersistentVolumeClaim) (map[string]string, error) {
+	return map[string]string{}, nil
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pvcrr
+// +kubebuilder:printcolumn:name="Claim",type=string,JSONPath=`.spec.claimName`
+// +kubebuilder:printcolumn:name="Size",type=string,JSONPath=`.spec.size`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Requested By",type=string,JSONPath=`.spec.requestedBy`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PersistentVolumeClaimResizeRequest is the Schema for the
+// persistentvolumeclaimresizerequests API. It requests a one-shot resize of a
+// PVC managed by a PersistentVolumeClaimAutoscaler or a
+// ClusterPersistentVolumeClaimAutoscaler, which is executed by the autoscaler.
+type PersistentVolumeClaimResizeRequest struct {
+	metav1.TypeMeta   `json:",inline"`            // nolint:revive
+	metav1.ObjectMeta `json:"metadata,omitempty"` // nolint:revive
+
+	Spec   PersistentVolumeClaimResizeRequestSpec   `json:"spec,omitempty"`
+	Status PersistentVolumeClaimResizeRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PersistentVolumeClaimResizeRequestList contains a list of PersistentVolumeClaimResizeRequest
+type PersistentVolumeClaimResizeRequestList struct {
+	metav1.TypeMeta `json:",inline"` // nolint:revive
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PersistentVolumeClaimResizeRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PersistentVolumeClaimResizeRequest{}, &PersistentVolumeClaimResizeRequestList{})
+}
+
+// PersistentVolumeClaimResizeRequestSpec defines the desired state of the
+// PersistentVolumeClaimResizeRequest. The spec is immutable.
+type PersistentVolumeClaimResizeRequestSpec struct {
+	// ClaimName is the name of the PVC in the namespace of the request, which
+	// should be resized.
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+
+	// Size is the requested size of the PVC. It must be larger than the
+	// current size of the PVC and must not exceed the max capacity of the
+	// volume policy of the PVC.
+	Size resource.Quantity `json:"size"`
+
+	// Reason describes why the resize has been requested.
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// RequestedBy is the name of the user who has created the request. It is
+	// set by the admission webhook.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// ResizeRequestPhase is the phase of a [PersistentVolumeClaimResizeRequest].
+type ResizeRequestPhase string
+
+const (
+	// ResizeRequestPhasePending means that the request has not been executed yet.
+	ResizeRequestPhasePending ResizeRequestPhase = "Pending"
+	// ResizeRequestPhaseInProgress means that the PVC has been patched and is being resized.
+	ResizeRequestPhaseInProgress ResizeRequestPhase = "InProgress"
+	// ResizeRequestPhaseSucceeded means that the capacity of the PVC has reached the requested size.
+	ResizeRequestPhaseSucceeded ResizeRequestPhase = "Succeeded"
+	// ResizeRequestPhaseFailed means that the request has been rejected or the resize has failed.
+	ResizeRequestPhaseFailed ResizeRequestPhase = "Failed"
+)
+
+// ResizeRequestReason is a brief reason for the phase of a
+// [PersistentVolumeClaimResizeRequest].
+type ResizeRequestReason string
+
+const (
+	// ResizeRequestReasonNotManaged means that the PVC is not managed by any autoscaler.
+	ResizeRequestReasonNotManaged ResizeRequestReason = "NotManaged"
+	// ResizeRequestReasonQuotaExceeded means that the quotas or storage budgets leave no room for the resize.
+	ResizeRequestReasonQuotaExceeded ResizeRequestReason = "QuotaExceeded"
+	// ResizeRequestReasonCapped means that the resize has been capped by a quota or storage budget.
+	ResizeRequestReasonCapped ResizeRequestReason = "Capped"
+)
+
+// PersistentVolumeClaimResizeRequestStatus defines the observed state of the
+// PersistentVolumeClaimResizeRequest.
+type PersistentVolumeClaimResizeRequestStatus struct {
+	// Phase is the phase of the request.
+	// +kubebuilder:validation:Enum=Pending;InProgress;Succeeded;Failed
+	// +optional
+	Phase ResizeRequestPhase `json:"phase,omitempty"`
+
+	// Reason is a brief reason for the phase of the request, if any.
+	// +kubebuilder:validation:Enum=NotManaged;QuotaExceeded;Capped
+	// +optional
+	Reason ResizeRequestReason `json:"reason,omitempty"`
+
+	// Message is a human-readable message about the phase of the request.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// From is the size of the PVC before the resize.
+	// +optional
+	From *resource.Quantity `json:"from,omitempty"`
+
+	// To is the size to which the PVC has been resized. It may be smaller
+	// than the requested size, when the resize has been capped by a quota or
+	// storage budget.
+	// +optional
+	To *resource.Quantity `json:"to,omitempty"`
+
+	// StartTime is the time at which the PVC has been patched.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time at which the request has succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// IsFinished returns whether the request has succeeded or failed.
+func (r *PersistentVolumeClaimResizeRequest) IsFinished() bool {
+	return r.Status.Phase == ResizeRequestPhaseSucceeded || r.Status.Phase == ResizeRequestPhaseFailed
+}
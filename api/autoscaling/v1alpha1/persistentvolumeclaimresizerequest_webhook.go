@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *PersistentVolumeClaimResizeRequest) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &PersistentVolumeClaimResizeRequest{}).
+		WithDefaulter(&PersistentVolumeClaimResizeRequestCustomDefaulter{}).
+		WithValidator(&PersistentVolumeClaimResizeRequestCustomValidator{Client: mgr.GetAPIReader()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-autoscaling-gardener-cloud-v1alpha1-persistentvolumeclaimresizerequest,mutating=true,failurePolicy=fail,sideEffects=None,groups=autoscaling.gardener.cloud,resources=persistentvolumeclaimresizerequests,verbs=create,versions=v1alpha1,name=mpersistentvolumeclaimresizerequest.kb.io,admissionReviewVersions=v1
+
+// PersistentVolumeClaimResizeRequestCustomDefaulter records the user who has
+// created a [PersistentVolumeClaimResizeRequest] in its spec.
+type PersistentVolumeClaimResizeRequestCustomDefaulter struct{}
+
+var _ admission.Defaulter[*PersistentVolumeClaimResizeRequest] = &PersistentVolumeClaimResizeRequestCustomDefaulter{}
+
+// Default implements [admission.Defaulter] so a webhook will be registered
+// for the type
+func (d *PersistentVolumeClaimResizeRequestCustomDefaulter) Default(ctx context.Context, obj *PersistentVolumeClaimResizeRequest) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The user is always taken from the request, so that it cannot be
+	// spoofed by the creator of the object.
+	if req.Operation == admissionv1.Create {
+		obj.Spec.RequestedBy = req.UserInfo.Username
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-autoscaling-gardener-cloud-v1alpha1-persistentvolumeclaimresizerequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=autoscaling.gardener.cloud,resources=persistentvolumeclaimresizerequests,verbs=create;update,versions=v1alpha1,name=vpersistentvolumeclaimresizerequest.kb.io,admissionReviewVersions=v1
+
+// PersistentVolumeClaimResizeRequestCustomValidator validates
+// [PersistentVolumeClaimResizeRequest] resources. The Client is used for
+// looking up the PVC of the request in order to return warnings about it.
+type PersistentVolumeClaimResizeRequestCustomValidator struct {
+	Client client.Reader
+}
+
+var _ admission.Validator[*PersistentVolumeClaimResizeRequest] = &PersistentVolumeClaimResizeRequestCustomValidator{}
+
+// ValidateCreate implements [admission.Validator] so a webhook will be
+// registered for the type
+func (v *PersistentVolumeClaimResizeRequestCustomValidator) ValidateCreate(ctx context.Context, obj *PersistentVolumeClaimResizeRequest) (admission.Warnings, error) {
+	allErrs := make(field.ErrorList, 0)
+	if obj.Spec.Size.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "size"), obj.Spec.Size.String(), "must be > 0"))
+	}
+
+	if err := allErrs.ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	return resizeRequestWarnings(ctx, v.Client, obj)
+}
+
+// ValidateUpdate implements [admission.Validator] so a webhook will be
+// registered for the type
+func (v *PersistentVolumeClaimResizeRequestCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *PersistentVolumeClaimResizeRequest) (admission.Warnings, error) {
+	if !apiequality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, field.Forbidden(field.NewPath("spec"), "field is immutable")
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements [admission.Validator] so a webhook will be
+// registered for the type
+func (v *PersistentVolumeClaimResizeRequestCustomValidator) ValidateDelete(ctx context.Context, obj *PersistentVolumeClaimResizeRequest) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// resizeRequestWarnings returns warnings when the PVC of the
+// [PersistentVolumeClaimResizeRequest] does not exist or is already at least
+// as large as the requested size.
+func resizeRequestWarnings(ctx context.Context, c client.Reader, obj *PersistentVolumeClaimResizeRequest) (admission.Warnings, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	var pvc corev1.PersistentVolumeClaim
+	if err := c.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: obj.Spec.ClaimName}, &pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Warnings{fmt.Sprintf("PersistentVolumeClaim %s/%s does not exist", obj.Namespace, obj.Spec.ClaimName)}, nil
+		}
+
+		return nil, err
+	}
+
+	if currSize := pvc.Spec.Resources.Requests.Storage(); currSize.Cmp(obj.Spec.Size) >= 0 {
+		return admission.Warnings{fmt.Sprintf("PersistentVolumeClaim %s/%s already requests %s, which is not smaller than the requested size %s", obj.Namespace, obj.Spec.ClaimName, currSize, obj.Spec.Size.String())}, nil
+	}
+
+	return nil, nil
+}
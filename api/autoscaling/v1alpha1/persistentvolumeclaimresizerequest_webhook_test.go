@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("PersistentVolumeClaimResizeRequest Webhook", func() {
+	var (
+		defaulter *PersistentVolumeClaimResizeRequestCustomDefaulter
+		validator *PersistentVolumeClaimResizeRequestCustomValidator
+		obj       *PersistentVolumeClaimResizeRequest
+	)
+
+	BeforeEach(func() {
+		scheme := apimachineryruntime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: "default"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+
+		defaulter = &PersistentVolumeClaimResizeRequestCustomDefaulter{}
+		validator = &PersistentVolumeClaimResizeRequestCustomValidator{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(pvc).Build(),
+		}
+
+		obj = &PersistentVolumeClaimResizeRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvcrr-1", Namespace: "default"},
+			Spec: PersistentVolumeClaimResizeRequestSpec{
+				ClaimName: "pvc-1",
+				Size:      resource.MustParse("5Gi"),
+				Reason:    "bulk import",
+			},
+		}
+	})
+
+	It("should record the requesting user on create", func() {
+		obj.Spec.RequestedBy = "someone-else"
+		reqCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			UserInfo:  authenticationv1.UserInfo{Username: "jane"},
+		}})
+
+		Expect(defaulter.Default(reqCtx, obj)).To(Succeed())
+		Expect(obj.Spec.RequestedBy).To(Equal("jane"))
+	})
+
+	It("should admit if all fields are valid", func() {
+		warnings, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should deny a zero size", func() {
+		obj.Spec.Size = resource.MustParse("0")
+
+		_, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).To(MatchError(ContainSubstring("spec.size")))
+	})
+
+	It("should deny changes of the spec", func() {
+		newObj := obj.DeepCopy()
+		newObj.Spec.Size = resource.MustParse("10Gi")
+
+		_, err := validator.ValidateUpdate(ctx, obj, newObj)
+		Expect(err).To(MatchError(ContainSubstring("field is immutable")))
+	})
+
+	It("should warn about a missing PVC", func() {
+		obj.Spec.ClaimName = "missing"
+
+		warnings, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("PersistentVolumeClaim default/missing does not exist"))
+	})
+
+	It("should warn when the PVC is not smaller than the requested size", func() {
+		obj.Spec.Size = resource.MustParse("1Gi")
+
+		warnings, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring("already requests 1Gi")))
+	})
+})
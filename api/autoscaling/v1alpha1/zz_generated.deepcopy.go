@@ -9,10 +9,102 @@
 package v1alpha1
 
 import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPersistentVolumeClaimAutoscaler) DeepCopyInto(out *ClusterPersistentVolumeClaimAutoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPersistentVolumeClaimAutoscaler.
+func (in *ClusterPersistentVolumeClaimAutoscaler) DeepCopy() *ClusterPersistentVolumeClaimAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPersistentVolumeClaimAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPersistentVolumeClaimAutoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPersistentVolumeClaimAutoscalerList) DeepCopyInto(out *ClusterPersistentVolumeClaimAutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterPersistentVolumeClaimAutoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPersistentVolumeClaimAutoscalerList.
+func (in *ClusterPersistentVolumeClaimAutoscalerList) DeepCopy() *ClusterPersistentVolumeClaimAutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPersistentVolumeClaimAutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPersistentVolumeClaimAutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPersistentVolumeClaimAutoscalerSpec) DeepCopyInto(out *ClusterPersistentVolumeClaimAutoscalerSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumePolicies != nil {
+		in, out := &in.VolumePolicies, &out.VolumePolicies
+		*out = make([]VolumePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPersistentVolumeClaimAutoscalerSpec.
+func (in *ClusterPersistentVolumeClaimAutoscalerSpec) DeepCopy() *ClusterPersistentVolumeClaimAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPersistentVolumeClaimAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CurrentVolumeStatus) DeepCopyInto(out *CurrentVolumeStatus) {
 	*out = *in
@@ -31,6 +123,11 @@ func (in *CurrentVolumeStatus) DeepCopyInto(out *CurrentVolumeStatus) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MonthlyCost != nil {
+		in, out := &in.MonthlyCost, &out.MonthlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentVolumeStatus.
@@ -46,6 +143,11 @@ func (in *CurrentVolumeStatus) DeepCopy() *CurrentVolumeStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Match) DeepCopyInto(out *Match) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Match.
@@ -121,6 +223,16 @@ func (in *PersistentVolumeClaimAutoscalerList) DeepCopyObject() runtime.Object {
 func (in *PersistentVolumeClaimAutoscalerSpec) DeepCopyInto(out *PersistentVolumeClaimAutoscalerSpec) {
 	*out = *in
 	out.TargetRef = in.TargetRef
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]autoscalingv1.CrossVersionObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(TargetSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumePolicies != nil {
 		in, out := &in.VolumePolicies, &out.VolumePolicies
 		*out = make([]VolumePolicy, len(*in))
@@ -128,6 +240,11 @@ func (in *PersistentVolumeClaimAutoscalerSpec) DeepCopyInto(out *PersistentVolum
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimAutoscalerSpec.
@@ -145,6 +262,19 @@ func (in *PersistentVolumeClaimAutoscalerStatus) DeepCopyInto(out *PersistentVol
 	*out = *in
 	in.LastCheck.DeepCopyInto(&out.LastCheck)
 	in.NextCheck.DeepCopyInto(&out.NextCheck)
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextCheckTime != nil {
+		in, out := &in.NextCheckTime, &out.NextCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxUtilizationPercent != nil {
+		in, out := &in.MaxUtilizationPercent, &out.MaxUtilizationPercent
+		*out = new(int)
+		**out = **in
+	}
 	if in.VolumeRecommendations != nil {
 		in, out := &in.VolumeRecommendations, &out.VolumeRecommendations
 		*out = make([]VolumeRecommendation, len(*in))
@@ -171,6 +301,142 @@ func (in *PersistentVolumeClaimAutoscalerStatus) DeepCopy() *PersistentVolumeCla
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimResizeRequest) DeepCopyInto(out *PersistentVolumeClaimResizeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimResizeRequest.
+func (in *PersistentVolumeClaimResizeRequest) DeepCopy() *PersistentVolumeClaimResizeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimResizeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentVolumeClaimResizeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimResizeRequestList) DeepCopyInto(out *PersistentVolumeClaimResizeRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PersistentVolumeClaimResizeRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimResizeRequestList.
+func (in *PersistentVolumeClaimResizeRequestList) DeepCopy() *PersistentVolumeClaimResizeRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimResizeRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentVolumeClaimResizeRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimResizeRequestSpec) DeepCopyInto(out *PersistentVolumeClaimResizeRequestSpec) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimResizeRequestSpec.
+func (in *PersistentVolumeClaimResizeRequestSpec) DeepCopy() *PersistentVolumeClaimResizeRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimResizeRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimResizeRequestStatus) DeepCopyInto(out *PersistentVolumeClaimResizeRequestStatus) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimResizeRequestStatus.
+func (in *PersistentVolumeClaimResizeRequestStatus) DeepCopy() *PersistentVolumeClaimResizeRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimResizeRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResizeHistoryEntry) DeepCopyInto(out *ResizeHistoryEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	out.From = in.From.DeepCopy()
+	out.To = in.To.DeepCopy()
+	if in.UsedSpacePercent != nil {
+		in, out := &in.UsedSpacePercent, &out.UsedSpacePercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.UsedInodesPercent != nil {
+		in, out := &in.UsedInodesPercent, &out.UsedInodesPercent
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResizeHistoryEntry.
+func (in *ResizeHistoryEntry) DeepCopy() *ResizeHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ResizeHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingRules) DeepCopyInto(out *ScalingRules) {
 	*out = *in
@@ -179,6 +445,11 @@ func (in *ScalingRules) DeepCopyInto(out *ScalingRules) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CriticalUtilizationPercent != nil {
+		in, out := &in.CriticalUtilizationPercent, &out.CriticalUtilizationPercent
+		*out = new(int)
+		**out = **in
+	}
 	if in.StepPercent != nil {
 		in, out := &in.StepPercent, &out.StepPercent
 		*out = new(int)
@@ -194,6 +465,16 @@ func (in *ScalingRules) DeepCopyInto(out *ScalingRules) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StabilizationWindow != nil {
+		in, out := &in.StabilizationWindow, &out.StabilizationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ApprovalExpiration != nil {
+		in, out := &in.ApprovalExpiration, &out.ApprovalExpiration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingRules.
@@ -214,6 +495,11 @@ func (in *TargetRecommendation) DeepCopyInto(out *TargetRecommendation) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MonthlyCost != nil {
+		in, out := &in.MonthlyCost, &out.MonthlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetRecommendation.
@@ -226,11 +512,32 @@ func (in *TargetRecommendation) DeepCopy() *TargetRecommendation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumePolicy) DeepCopyInto(out *VolumePolicy) {
 	*out = *in
-	out.Match = in.Match
+	in.Match.DeepCopyInto(&out.Match)
 	out.MaxCapacity = in.MaxCapacity.DeepCopy()
+	if in.MaxMonthlyCost != nil {
+		in, out := &in.MaxMonthlyCost, &out.MaxMonthlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.ScaleUp != nil {
 		in, out := &in.ScaleUp, &out.ScaleUp
 		*out = new(ScalingRules)
@@ -253,10 +560,38 @@ func (in *VolumeRecommendation) DeepCopyInto(out *VolumeRecommendation) {
 	*out = *in
 	in.Current.DeepCopyInto(&out.Current)
 	in.Target.DeepCopyInto(&out.Target)
+	if in.VolumePolicyIndex != nil {
+		in, out := &in.VolumePolicyIndex, &out.VolumePolicyIndex
+		*out = new(int)
+		**out = **in
+	}
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(autoscalingv1.CrossVersionObjectReference)
+		**out = **in
+	}
 	if in.LastResizeTime != nil {
 		in, out := &in.LastResizeTime, &out.LastResizeTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ThresholdBreachStartTime != nil {
+		in, out := &in.ThresholdBreachStartTime, &out.ThresholdBreachStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResizeHistory != nil {
+		in, out := &in.ResizeHistory, &out.ResizeHistory
+		*out = make([]ResizeHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRecommendation.
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+// ConvertTo converts this PersistentVolumeClaimAutoscaler to the hub version
+// (v1alpha1).
+func (src *PersistentVolumeClaimAutoscaler) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.PersistentVolumeClaimAutoscaler)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+		AutoscalerName: src.Spec.AutoscalerName,
+		TargetRef:      src.Spec.TargetRef,
+		TargetRefs:     src.Spec.TargetRefs,
+		TargetSelector: (*v1alpha1.TargetSelector)(src.Spec.TargetSelector),
+		Suspend:        src.Spec.Suspend,
+		CheckInterval:  src.Spec.CheckInterval,
+	}
+	for _, policy := range src.Spec.VolumePolicies {
+		// An empty scaleUp maps back to a nil one, so that hub objects
+		// without scaling rules survive a round-trip unchanged.
+		var scaleUp *v1alpha1.ScalingRules
+		if policy.ScaleUp != (ScalingRules{}) {
+			scaleUp = ptr.To(convertScalingRulesToHub(policy.ScaleUp))
+		}
+		dst.Spec.VolumePolicies = append(dst.Spec.VolumePolicies, v1alpha1.VolumePolicy{
+			Match:          v1alpha1.Match(policy.Match),
+			MaxCapacity:    policy.MaxCapacity,
+			MaxMonthlyCost: policy.MaxMonthlyCost,
+			ScaleUp:        scaleUp,
+			UniformScaling: policy.UniformScaling,
+		})
+	}
+
+	dst.Status = v1alpha1.PersistentVolumeClaimAutoscalerStatus{
+		ObservedGeneration:    src.Status.ObservedGeneration,
+		LastReconcileTime:     src.Status.LastReconcileTime,
+		NextCheckTime:         src.Status.NextCheckTime,
+		ManagedPVCs:           src.Status.ManagedPVCs,
+		HealthyPVCs:           src.Status.HealthyPVCs,
+		ResizingPVCs:          src.Status.ResizingPVCs,
+		MaxUtilizationPercent: src.Status.MaxUtilizationPercent,
+		Conditions:            src.Status.Conditions,
+	}
+	for _, rec := range src.Status.VolumeRecommendations {
+		dst.Status.VolumeRecommendations = append(dst.Status.VolumeRecommendations, v1alpha1.VolumeRecommendation{
+			Name:                     rec.Name,
+			Namespace:                rec.Namespace,
+			UID:                      rec.UID,
+			Current:                  v1alpha1.CurrentVolumeStatus(rec.Current),
+			Target:                   v1alpha1.TargetRecommendation(rec.Target),
+			VolumePolicyIndex:        rec.VolumePolicyIndex,
+			Source:                   rec.Source,
+			TargetRef:                rec.TargetRef,
+			LastResizeTime:           rec.LastResizeTime,
+			ThresholdBreachStartTime: rec.ThresholdBreachStartTime,
+			Conditions:               rec.Conditions,
+			ResizeHistory:            convertResizeHistoryToHub(rec.ResizeHistory),
+		})
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) to this
+// PersistentVolumeClaimAutoscaler. The deprecated lastCheck and nextCheck
+// status fields are dropped.
+func (dst *PersistentVolumeClaimAutoscaler) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.PersistentVolumeClaimAutoscaler)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = PersistentVolumeClaimAutoscalerSpec{
+		AutoscalerName: src.Spec.AutoscalerName,
+		TargetRef:      src.Spec.TargetRef,
+		TargetRefs:     src.Spec.TargetRefs,
+		TargetSelector: (*TargetSelector)(src.Spec.TargetSelector),
+		Suspend:        src.Spec.Suspend,
+		CheckInterval:  src.Spec.CheckInterval,
+	}
+	for _, policy := range src.Spec.VolumePolicies {
+		var scaleUp ScalingRules
+		if policy.ScaleUp != nil {
+			scaleUp = convertScalingRulesFromHub(*policy.ScaleUp)
+		}
+		dst.Spec.VolumePolicies = append(dst.Spec.VolumePolicies, VolumePolicy{
+			Match:          Match(policy.Match),
+			MaxCapacity:    policy.MaxCapacity,
+			MaxMonthlyCost: policy.MaxMonthlyCost,
+			ScaleUp:        scaleUp,
+			UniformScaling: policy.UniformScaling,
+		})
+	}
+
+	dst.Status = PersistentVolumeClaimAutoscalerStatus{
+		ObservedGeneration:    src.Status.ObservedGeneration,
+		LastReconcileTime:     src.Status.LastReconcileTime,
+		NextCheckTime:         src.Status.NextCheckTime,
+		ManagedPVCs:           src.Status.ManagedPVCs,
+		HealthyPVCs:           src.Status.HealthyPVCs,
+		ResizingPVCs:          src.Status.ResizingPVCs,
+		MaxUtilizationPercent: src.Status.MaxUtilizationPercent,
+		Conditions:            src.Status.Conditions,
+	}
+	for _, rec := range src.Status.VolumeRecommendations {
+		dst.Status.VolumeRecommendations = append(dst.Status.VolumeRecommendations, VolumeRecommendation{
+			Name:                     rec.Name,
+			Namespace:                rec.Namespace,
+			UID:                      rec.UID,
+			Current:                  CurrentVolumeStatus(rec.Current),
+			Target:                   TargetRecommendation(rec.Target),
+			VolumePolicyIndex:        rec.VolumePolicyIndex,
+			Source:                   rec.Source,
+			TargetRef:                rec.TargetRef,
+			LastResizeTime:           rec.LastResizeTime,
+			ThresholdBreachStartTime: rec.ThresholdBreachStartTime,
+			Conditions:               rec.Conditions,
+			ResizeHistory:            convertResizeHistoryFromHub(rec.ResizeHistory),
+		})
+	}
+
+	return nil
+}
+
+func convertScalingRulesToHub(in ScalingRules) v1alpha1.ScalingRules {
+	return v1alpha1.ScalingRules{
+		UtilizationThresholdPercent: in.UtilizationThresholdPercent,
+		CriticalUtilizationPercent:  in.CriticalUtilizationPercent,
+		StepPercent:                 in.StepPercent,
+		MinStepAbsolute:             in.MinStepAbsolute,
+		CooldownDuration:            in.CooldownDuration,
+		StabilizationWindow:         in.StabilizationWindow,
+		ResizeStrategy:              v1alpha1.VolumeResizeStrategy(in.ResizeStrategy),
+		ApprovalExpiration:          in.ApprovalExpiration,
+	}
+}
+
+func convertScalingRulesFromHub(in v1alpha1.ScalingRules) ScalingRules {
+	return ScalingRules{
+		UtilizationThresholdPercent: in.UtilizationThresholdPercent,
+		CriticalUtilizationPercent:  in.CriticalUtilizationPercent,
+		StepPercent:                 in.StepPercent,
+		MinStepAbsolute:             in.MinStepAbsolute,
+		CooldownDuration:            in.CooldownDuration,
+		StabilizationWindow:         in.StabilizationWindow,
+		ResizeStrategy:              VolumeResizeStrategy(in.ResizeStrategy),
+		ApprovalExpiration:          in.ApprovalExpiration,
+	}
+}
+
+func convertResizeHistoryToHub(in []ResizeHistoryEntry) []v1alpha1.ResizeHistoryEntry {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1alpha1.ResizeHistoryEntry, 0, len(in))
+	for _, entry := range in {
+		out = append(out, v1alpha1.ResizeHistoryEntry{
+			Time:              entry.Time,
+			From:              entry.From,
+			To:                entry.To,
+			Trigger:           v1alpha1.ResizeTrigger(entry.Trigger),
+			UsedSpacePercent:  entry.UsedSpacePercent,
+			UsedInodesPercent: entry.UsedInodesPercent,
+			Outcome:           v1alpha1.ResizeOutcome(entry.Outcome),
+			Message:           entry.Message,
+		})
+	}
+
+	return out
+}
+
+func convertResizeHistoryFromHub(in []v1alpha1.ResizeHistoryEntry) []ResizeHistoryEntry {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]ResizeHistoryEntry, 0, len(in))
+	for _, entry := range in {
+		out = append(out, ResizeHistoryEntry{
+			Time:              entry.Time,
+			From:              entry.From,
+			To:                entry.To,
+			Trigger:           ResizeTrigger(entry.Trigger),
+			UsedSpacePercent:  entry.UsedSpacePercent,
+			UsedInodesPercent: entry.UsedInodesPercent,
+			Outcome:           ResizeOutcome(entry.Outcome),
+			Message:           entry.Message,
+		})
+	}
+
+	return out
+}
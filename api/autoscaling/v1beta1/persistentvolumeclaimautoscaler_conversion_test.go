@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+var _ = Describe("PersistentVolumeClaimAutoscaler conversion", func() {
+	var (
+		now   metav1.Time
+		spoke *PersistentVolumeClaimAutoscaler
+	)
+
+	BeforeEach(func() {
+		now = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		spoke = &PersistentVolumeClaimAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-pvca",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "test"},
+			},
+			Spec: PersistentVolumeClaimAutoscalerSpec{
+				AutoscalerName: "test-autoscaler",
+				Suspend:        true,
+				TargetRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       "test-sts",
+				},
+				TargetRefs: []autoscalingv1.CrossVersionObjectReference{
+					{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "test-sts-2"},
+				},
+				TargetSelector: &TargetSelector{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Selector:   metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+				},
+				VolumePolicies: []VolumePolicy{
+					{
+						Match: Match{
+							Name:                "data-*",
+							Selector:            &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+							StorageClassName:    "premium-ssd",
+							VolumeClaimTemplate: "data",
+						},
+						MaxCapacity:    resource.MustParse("100Gi"),
+						MaxMonthlyCost: ptr.To(resource.MustParse("20")),
+						ScaleUp: ScalingRules{
+							UtilizationThresholdPercent: ptr.To(80),
+							CriticalUtilizationPercent:  ptr.To(95),
+							StepPercent:                 ptr.To(20),
+							MinStepAbsolute:             ptr.To(resource.MustParse("2Gi")),
+							CooldownDuration:            &metav1.Duration{Duration: time.Hour},
+							StabilizationWindow:         &metav1.Duration{Duration: 10 * time.Minute},
+							ResizeStrategy:              InPlaceVolumeResizeStrategy,
+						},
+						UniformScaling: true,
+					},
+					{
+						MaxCapacity: resource.MustParse("10Gi"),
+					},
+				},
+				CheckInterval: &metav1.Duration{Duration: 30 * time.Second},
+			},
+			Status: PersistentVolumeClaimAutoscalerStatus{
+				ObservedGeneration:    2,
+				LastReconcileTime:     &now,
+				NextCheckTime:         &now,
+				ManagedPVCs:           1,
+				HealthyPVCs:           1,
+				ResizingPVCs:          1,
+				MaxUtilizationPercent: ptr.To(85),
+				VolumeRecommendations: []VolumeRecommendation{
+					{
+						Name:      "data-test-sts-0",
+						Namespace: "default",
+						Current: CurrentVolumeStatus{
+							UsedSpacePercent:  ptr.To(85),
+							UsedInodesPercent: ptr.To(10),
+							Size:              ptr.To(resource.MustParse("10Gi")),
+							MonthlyCost:       ptr.To(resource.MustParse("2")),
+						},
+						Target: TargetRecommendation{
+							Size:        ptr.To(resource.MustParse("12Gi")),
+							MonthlyCost: ptr.To(resource.MustParse("2.4")),
+						},
+						VolumePolicyIndex:        ptr.To(0),
+						Source:                   "PersistentVolumeClaimAutoscaler/test-pvca",
+						TargetRef:                &autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "test-sts"},
+						LastResizeTime:           &now,
+						ThresholdBreachStartTime: &now,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(ConditionTypeResizing),
+								Status:             metav1.ConditionTrue,
+								Reason:             "Reconcile",
+								Message:            "resizing from 10Gi to 12Gi due to passing storage threshold",
+								LastTransitionTime: now,
+							},
+						},
+						ResizeHistory: []ResizeHistoryEntry{
+							{
+								Time:             now,
+								From:             resource.MustParse("10Gi"),
+								To:               resource.MustParse("12Gi"),
+								Trigger:          ResizeTriggerSpace,
+								UsedSpacePercent: ptr.To(85),
+								Outcome:          ResizeOutcomeInProgress,
+							},
+						},
+					},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(ConditionTypeRecommendationAvailable),
+						Status:             metav1.ConditionTrue,
+						Reason:             "RecommendationsProvided",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		}
+	})
+
+	It("should round-trip a v1beta1 object through the hub", func() {
+		hub := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+		Expect(spoke.ConvertTo(hub)).To(Succeed())
+
+		Expect(hub.Spec.VolumePolicies).To(HaveLen(2))
+		Expect(hub.Spec.VolumePolicies[0].ScaleUp).NotTo(BeNil())
+		Expect(hub.Spec.VolumePolicies[0].ScaleUp.StepPercent).To(Equal(ptr.To(20)))
+		Expect(hub.Spec.VolumePolicies[1].ScaleUp).To(BeNil())
+
+		converted := &PersistentVolumeClaimAutoscaler{}
+		Expect(converted.ConvertFrom(hub)).To(Succeed())
+		Expect(converted).To(Equal(spoke))
+	})
+
+	It("should round-trip a v1alpha1 object through v1beta1", func() {
+		hub := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+		Expect(spoke.ConvertTo(hub)).To(Succeed())
+		expected := hub.DeepCopy()
+
+		converted := &PersistentVolumeClaimAutoscaler{}
+		Expect(converted.ConvertFrom(hub)).To(Succeed())
+		roundTripped := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+		Expect(converted.ConvertTo(roundTripped)).To(Succeed())
+		Expect(roundTripped).To(Equal(expected))
+	})
+
+	It("should default a nil scaleUp and drop the deprecated status fields", func() {
+		hub := &v1alpha1.PersistentVolumeClaimAutoscaler{
+			Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+				VolumePolicies: []v1alpha1.VolumePolicy{{MaxCapacity: resource.MustParse("10Gi")}},
+			},
+			Status: v1alpha1.PersistentVolumeClaimAutoscalerStatus{
+				LastCheck: now,
+				NextCheck: now,
+			},
+		}
+
+		converted := &PersistentVolumeClaimAutoscaler{}
+		Expect(converted.ConvertFrom(hub)).To(Succeed())
+		Expect(converted.Spec.VolumePolicies).To(HaveLen(1))
+		Expect(converted.Spec.VolumePolicies[0].ScaleUp).To(Equal(ScalingRules{}))
+		Expect(converted.Status).To(Equal(PersistentVolumeClaimAutoscalerStatus{}))
+	})
+
+	It("should keep a nil scaleUp when round-tripping a v1alpha1 object", func() {
+		hub := &v1alpha1.PersistentVolumeClaimAutoscaler{
+			Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+				VolumePolicies: []v1alpha1.VolumePolicy{{MaxCapacity: resource.MustParse("10Gi")}},
+			},
+		}
+		expected := hub.DeepCopy()
+
+		converted := &PersistentVolumeClaimAutoscaler{}
+		Expect(converted.ConvertFrom(hub)).To(Succeed())
+		roundTripped := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+		Expect(converted.ConvertTo(roundTripped)).To(Succeed())
+		Expect(roundTripped.Spec.VolumePolicies[0].ScaleUp).To(BeNil())
+		Expect(roundTripped).To(Equal(expected))
+	})
+
+	It("should be convertible", func() {
+		scheme := runtime.NewScheme()
+		Expect(AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		ok, err := conversion.IsConvertible(scheme, &PersistentVolumeClaimAutoscaler{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+	})
+})
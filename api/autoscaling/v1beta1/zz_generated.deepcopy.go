@@ -0,0 +1,400 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentVolumeStatus) DeepCopyInto(out *CurrentVolumeStatus) {
+	*out = *in
+	if in.UsedSpacePercent != nil {
+		in, out := &in.UsedSpacePercent, &out.UsedSpacePercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.UsedInodesPercent != nil {
+		in, out := &in.UsedInodesPercent, &out.UsedInodesPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MonthlyCost != nil {
+		in, out := &in.MonthlyCost, &out.MonthlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentVolumeStatus.
+func (in *CurrentVolumeStatus) DeepCopy() *CurrentVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Match) DeepCopyInto(out *Match) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Match.
+func (in *Match) DeepCopy() *Match {
+	if in == nil {
+		return nil
+	}
+	out := new(Match)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimAutoscaler) DeepCopyInto(out *PersistentVolumeClaimAutoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimAutoscaler.
+func (in *PersistentVolumeClaimAutoscaler) DeepCopy() *PersistentVolumeClaimAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentVolumeClaimAutoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimAutoscalerList) DeepCopyInto(out *PersistentVolumeClaimAutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PersistentVolumeClaimAutoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimAutoscalerList.
+func (in *PersistentVolumeClaimAutoscalerList) DeepCopy() *PersistentVolumeClaimAutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimAutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentVolumeClaimAutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimAutoscalerSpec) DeepCopyInto(out *PersistentVolumeClaimAutoscalerSpec) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]autoscalingv1.CrossVersionObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(TargetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumePolicies != nil {
+		in, out := &in.VolumePolicies, &out.VolumePolicies
+		*out = make([]VolumePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimAutoscalerSpec.
+func (in *PersistentVolumeClaimAutoscalerSpec) DeepCopy() *PersistentVolumeClaimAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimAutoscalerStatus) DeepCopyInto(out *PersistentVolumeClaimAutoscalerStatus) {
+	*out = *in
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextCheckTime != nil {
+		in, out := &in.NextCheckTime, &out.NextCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxUtilizationPercent != nil {
+		in, out := &in.MaxUtilizationPercent, &out.MaxUtilizationPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.VolumeRecommendations != nil {
+		in, out := &in.VolumeRecommendations, &out.VolumeRecommendations
+		*out = make([]VolumeRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimAutoscalerStatus.
+func (in *PersistentVolumeClaimAutoscalerStatus) DeepCopy() *PersistentVolumeClaimAutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimAutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResizeHistoryEntry) DeepCopyInto(out *ResizeHistoryEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	out.From = in.From.DeepCopy()
+	out.To = in.To.DeepCopy()
+	if in.UsedSpacePercent != nil {
+		in, out := &in.UsedSpacePercent, &out.UsedSpacePercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.UsedInodesPercent != nil {
+		in, out := &in.UsedInodesPercent, &out.UsedInodesPercent
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResizeHistoryEntry.
+func (in *ResizeHistoryEntry) DeepCopy() *ResizeHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ResizeHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingRules) DeepCopyInto(out *ScalingRules) {
+	*out = *in
+	if in.UtilizationThresholdPercent != nil {
+		in, out := &in.UtilizationThresholdPercent, &out.UtilizationThresholdPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.CriticalUtilizationPercent != nil {
+		in, out := &in.CriticalUtilizationPercent, &out.CriticalUtilizationPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.StepPercent != nil {
+		in, out := &in.StepPercent, &out.StepPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinStepAbsolute != nil {
+		in, out := &in.MinStepAbsolute, &out.MinStepAbsolute
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CooldownDuration != nil {
+		in, out := &in.CooldownDuration, &out.CooldownDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StabilizationWindow != nil {
+		in, out := &in.StabilizationWindow, &out.StabilizationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ApprovalExpiration != nil {
+		in, out := &in.ApprovalExpiration, &out.ApprovalExpiration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingRules.
+func (in *ScalingRules) DeepCopy() *ScalingRules {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetRecommendation) DeepCopyInto(out *TargetRecommendation) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MonthlyCost != nil {
+		in, out := &in.MonthlyCost, &out.MonthlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetRecommendation.
+func (in *TargetRecommendation) DeepCopy() *TargetRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicy) DeepCopyInto(out *VolumePolicy) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	out.MaxCapacity = in.MaxCapacity.DeepCopy()
+	if in.MaxMonthlyCost != nil {
+		in, out := &in.MaxMonthlyCost, &out.MaxMonthlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.ScaleUp.DeepCopyInto(&out.ScaleUp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicy.
+func (in *VolumePolicy) DeepCopy() *VolumePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRecommendation) DeepCopyInto(out *VolumeRecommendation) {
+	*out = *in
+	in.Current.DeepCopyInto(&out.Current)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.VolumePolicyIndex != nil {
+		in, out := &in.VolumePolicyIndex, &out.VolumePolicyIndex
+		*out = new(int)
+		**out = **in
+	}
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(autoscalingv1.CrossVersionObjectReference)
+		**out = **in
+	}
+	if in.LastResizeTime != nil {
+		in, out := &in.LastResizeTime, &out.LastResizeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ThresholdBreachStartTime != nil {
+		in, out := &in.ThresholdBreachStartTime, &out.ThresholdBreachStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResizeHistory != nil {
+		in, out := &in.ResizeHistory, &out.ResizeHistory
+		*out = make([]ResizeHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRecommendation.
+func (in *VolumeRecommendation) DeepCopy() *VolumeRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -9,11 +9,13 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/dynamic"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -29,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1beta1"
 	"github.com/gardener/pvc-autoscaler/internal/common"
 	"github.com/gardener/pvc-autoscaler/internal/healthcheck"
 	_ "github.com/gardener/pvc-autoscaler/internal/metrics"
@@ -37,6 +40,7 @@ import (
 	"github.com/gardener/pvc-autoscaler/internal/periodic"
 	"github.com/gardener/pvc-autoscaler/internal/target/pvcfetcher"
 	"github.com/gardener/pvc-autoscaler/internal/target/selectorfetcher"
+	"github.com/gardener/pvc-autoscaler/internal/workload"
 )
 
 var (
@@ -48,6 +52,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -65,6 +70,9 @@ func main() {
 	var metricsAvailableInodesQuery string
 	var metricsCapacityInodesQuery string
 	var autoscalerName string
+	var enablePVCAGeneration bool
+	var enableEventReconciliation bool
+	selectorPaths := map[schema.GroupKind]string{}
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -84,8 +92,24 @@ func main() {
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 
-	flag.DurationVar(&interval, "interval", 5*time.Minute, "The interval at which to run the periodic check")
+	flag.DurationVar(&interval, "interval", 5*time.Minute, "The default interval at which the PVCs of an autoscaler are checked")
 	flag.StringVar(&autoscalerName, "autoscaler-name", "", "Only reconcile PVCAs with this autoscalerName value. An empty value (default) reconciles PVCAs with no autoscalerName set.")
+	flag.BoolVar(&enablePVCAGeneration, "enable-pvca-generation", true,
+		"If set, PVCAs are generated for StatefulSets and Deployments annotated with "+common.AnnotationPVCAutoscaler+"=enabled")
+	flag.BoolVar(&enableEventReconciliation, "enable-event-reconciliation", true,
+		"If set, PVCAs are reconciled with the latest metrics as soon as they, their PVCs or the pods using them change")
+	flag.Func("selector-path",
+		"JSONPath to the pod label selector of targets of a kind without a scale subresource, "+
+			"in the form <kind>.<group>=<jsonpath>, e.g. Postgresql.acid.zalan.do={.spec.selector}. May be repeated.",
+		func(value string) error {
+			kind, path, ok := strings.Cut(value, "=")
+			if !ok || kind == "" || path == "" {
+				return fmt.Errorf("expected <kind>.<group>=<jsonpath>, got %q", value)
+			}
+			selectorPaths[schema.ParseGroupKind(kind)] = path
+
+			return nil
+		})
 
 	opts := zap.Options{
 		Development: true,
@@ -150,6 +174,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := periodic.AddStorageClassFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to set up field indexer", "controller", common.ControllerName)
+		os.Exit(1)
+	}
+
 	prometheusOpts := []prometheus.Option{
 		prometheus.WithAddress(prometheusAddress),
 		prometheus.WithAvailableBytesQuery(metricsAvailableBytesQuery),
@@ -164,7 +193,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	pvcFetcher, err := newPVCFetcher(mgr.GetRESTMapper(), mgr.GetConfig(), mgr.GetClient())
+	pvcFetcher, err := newPVCFetcher(mgr.GetRESTMapper(), mgr.GetConfig(), mgr.GetClient(), mgr.GetAPIReader(), selectorPaths)
 	if err != nil {
 		setupLog.Error(err, "unable to create PersistentVolumeClaim fetcher", "controller", common.ControllerName)
 		os.Exit(1)
@@ -192,8 +221,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	if enableEventReconciliation {
+		if err := runner.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up event-driven reconciliation", "controller", common.ControllerName)
+			os.Exit(1)
+		}
+	}
+
+	if enablePVCAGeneration {
+		generator, err := workload.New(
+			workload.WithClient(mgr.GetClient()),
+			workload.WithEventRecorder(mgr.GetEventRecorderFor(common.ControllerName)),
+			workload.WithAutoscalerName(autoscalerName),
+		)
+		if err != nil {
+			setupLog.Error(err, "unable to create PVCA generator", "controller", common.ControllerName)
+			os.Exit(1)
+		}
+
+		if err := generator.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up PVCA generator", "controller", common.ControllerName)
+			os.Exit(1)
+		}
+	}
+
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err = (&v1alpha1.PersistentVolumeClaimAutoscaler{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&v1alpha1.PersistentVolumeClaimAutoscaler{}).SetupWebhookWithManager(mgr, pvcFetcher); err != nil {
+			setupLog.Error(err, "unable to create webhook", "controller", common.ControllerName)
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.ClusterPersistentVolumeClaimAutoscaler{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "controller", common.ControllerName)
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.PersistentVolumeClaimResizeRequest{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "controller", common.ControllerName)
 			os.Exit(1)
 		}
@@ -217,7 +278,7 @@ func main() {
 	}
 }
 
-func newPVCFetcher(restMapper meta.RESTMapper, config *rest.Config, c client.Client) (pvcfetcher.Fetcher, error) {
+func newPVCFetcher(restMapper meta.RESTMapper, config *rest.Config, c client.Client, apiReader client.Reader, selectorPaths map[schema.GroupKind]string) (pvcfetcher.Fetcher, error) {
 	scalesClient, err := newScalesClient(restMapper, config)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create scales client: %w", err)
@@ -226,6 +287,8 @@ func newPVCFetcher(restMapper meta.RESTMapper, config *rest.Config, c client.Cli
 	selectorFetcher, err := selectorfetcher.New(
 		selectorfetcher.WithRESTMapper(restMapper),
 		selectorfetcher.WithScaleClient(scalesClient),
+		selectorfetcher.WithClient(c),
+		selectorfetcher.WithSelectorPaths(selectorPaths),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create selector fetcher: %w", err)
@@ -233,6 +296,7 @@ func newPVCFetcher(restMapper meta.RESTMapper, config *rest.Config, c client.Cli
 
 	return pvcfetcher.New(
 		pvcfetcher.WithClient(c),
+		pvcfetcher.WithAPIReader(apiReader),
 		pvcfetcher.WithSelectorFetcher(selectorFetcher),
 	)
 }
@@ -4,7 +4,11 @@
 
 package common
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
 
 // ErrNoMaxCapacity is an error which is returned when a PVC does not specify
 // the max capacity.
@@ -37,11 +41,20 @@ const (
 
 	// DefaultThresholdPercent is the default threshold value, if not
 	// specified for a PVC object.
-	DefaultThresholdPercent = 80
+	DefaultThresholdPercent = v1alpha1.DefaultThresholdPercent
 
 	// DefaultStepPercent is the default increase-by value, if not
 	// specified for a PVC object.
-	DefaultStepPercent = 10
+	DefaultStepPercent = v1alpha1.DefaultStepPercent
+
+	// DefaultMinStepAbsolute is the default minimum absolute increase-by
+	// value, if not specified for a PVC object.
+	DefaultMinStepAbsolute = v1alpha1.DefaultMinStepAbsolute
+
+	// DefaultApprovalExpiration is the default time for which an approval of
+	// a resize is valid with the Manual resize strategy, if not specified for
+	// a PVC object.
+	DefaultApprovalExpiration = v1alpha1.DefaultApprovalExpiration
 
 	// ScalingResolutionBytes is the smallest possible step. Any storage
 	// request set by the autoscaler is guaranteed to be divisible by that
@@ -59,4 +72,63 @@ const (
 	// cleared after a successful resize, to indicate that the autoscaler has done
 	// work on this PVC.
 	AnnotationPreviousSize = "pvc.autoscaling.gardener.cloud/prev-size"
+
+	// AnnotationStorageBudget specifies the total storage, which may be
+	// requested by all PVCs of a Namespace or of a StorageClass, when set on
+	// the respective object. Resizes which would exceed the budget are capped
+	// or skipped.
+	AnnotationStorageBudget = "pvc.autoscaling.gardener.cloud/storage-budget"
+
+	// AnnotationPricePerGiBMonth specifies the price of one GiB of storage
+	// per month, when set on a StorageClass. It is used for computing the
+	// monthly cost of the PVCs of the StorageClass.
+	AnnotationPricePerGiBMonth = "pvc.autoscaling.gardener.cloud/price-per-gib-month"
+
+	// AnnotationPaused pauses resizing of a single PVC, when set to "true" on
+	// it. Metrics are still collected and recommendations are still provided
+	// for the PVC.
+	AnnotationPaused = "pvc.autoscaling.gardener.cloud/paused"
+
+	// AnnotationApprovedSize approves a resize of a PVC with the Manual
+	// resize strategy, when set on the PVC or on its autoscaler. The value
+	// must be exactly the size which the autoscaler recommends. When set on
+	// the autoscaler, it approves the resize of every PVC of the autoscaler
+	// with that recommended size.
+	AnnotationApprovedSize = "pvc.autoscaling.gardener.cloud/approved-size"
+
+	// AnnotationApprovedAt specifies the time in RFC 3339 format, at which
+	// the size in [AnnotationApprovedSize] has been approved. The approval
+	// expires once the approval expiration of the volume policy has passed.
+	AnnotationApprovedAt = "pvc.autoscaling.gardener.cloud/approved-at"
+
+	// AnnotationPVCAutoscaler enables the generation of a
+	// PersistentVolumeClaimAutoscaler for a workload, when set to
+	// [AnnotationPVCAutoscalerEnabled] on it. The generated
+	// PersistentVolumeClaimAutoscaler is owned by the workload.
+	AnnotationPVCAutoscaler = "autoscaling.gardener.cloud/pvc-autoscaler"
+
+	// AnnotationPVCAutoscalerEnabled is the value of [AnnotationPVCAutoscaler],
+	// which enables the generation of a PersistentVolumeClaimAutoscaler.
+	AnnotationPVCAutoscalerEnabled = "enabled"
+
+	// AnnotationMaxCapacity specifies the max capacity of the volume policy of
+	// a generated PersistentVolumeClaimAutoscaler. It is required.
+	AnnotationMaxCapacity = "autoscaling.gardener.cloud/pvc-autoscaler-max-capacity"
+
+	// AnnotationUtilizationThresholdPercent specifies the utilization
+	// threshold of the volume policy of a generated
+	// PersistentVolumeClaimAutoscaler.
+	AnnotationUtilizationThresholdPercent = "autoscaling.gardener.cloud/pvc-autoscaler-utilization-threshold-percent"
+
+	// AnnotationStepPercent specifies the step percentage of the volume policy
+	// of a generated PersistentVolumeClaimAutoscaler.
+	AnnotationStepPercent = "autoscaling.gardener.cloud/pvc-autoscaler-step-percent"
+
+	// AnnotationMinStepAbsolute specifies the minimum absolute step of the
+	// volume policy of a generated PersistentVolumeClaimAutoscaler.
+	AnnotationMinStepAbsolute = "autoscaling.gardener.cloud/pvc-autoscaler-min-step-absolute"
+
+	// AnnotationCooldownDuration specifies the cooldown duration of the volume
+	// policy of a generated PersistentVolumeClaimAutoscaler.
+	AnnotationCooldownDuration = "autoscaling.gardener.cloud/pvc-autoscaler-cooldown-duration"
 )
@@ -36,6 +36,18 @@ var (
 		[]string{"namespace", "persistentvolumeclaim", "reason"},
 	)
 
+	// CriticalThresholdReachedTotal is a metric which increments each time
+	// the used capacity (space or inodes) for a PVC passes the critical
+	// threshold and the cooldown duration is bypassed.
+	CriticalThresholdReachedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "critical_threshold_reached_total",
+			Help:      "Total number of times the used capacity for a PVC has passed the critical threshold",
+		},
+		[]string{"namespace", "persistentvolumeclaim", "reason"},
+	)
+
 	// MaxCapacityReachedTotal is a metric which increments each time the
 	// max capacity for a PVC has been reached.
 	MaxCapacityReachedTotal = prometheus.NewCounterVec(
@@ -47,6 +59,40 @@ var (
 		[]string{"namespace", "persistentvolumeclaim"},
 	)
 
+	// QuotaExceededTotal is a metric which increments each time a PVC
+	// resize is skipped, because it would exceed a ResourceQuota or storage
+	// budget.
+	QuotaExceededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "quota_exceeded_total",
+			Help:      "Total number of times a PVC resize has been skipped due to a quota or storage budget",
+		},
+		[]string{"namespace", "persistentvolumeclaim"},
+	)
+
+	// MonthlyCost is a metric which reports the current and the projected
+	// monthly cost of a PVC, based on the price of its StorageClass.
+	MonthlyCost = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "monthly_cost",
+			Help:      "Current and projected monthly cost of a PVC",
+		},
+		[]string{"namespace", "persistentvolumeclaim", "type"},
+	)
+
+	// AutoscalerMonthlyCost is a metric which reports the current and the
+	// projected monthly cost of all PVCs managed by a PVCA.
+	AutoscalerMonthlyCost = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "autoscaler_monthly_cost",
+			Help:      "Current and projected monthly cost of all PVCs managed by a PVCA",
+		},
+		[]string{"namespace", "persistentvolumeclaimautoscaler", "type"},
+	)
+
 	// SkippedTotal is a metric which increments each time a PVC is skipped
 	// from being reconciled.
 	SkippedTotal = prometheus.NewCounterVec(
@@ -60,5 +106,5 @@ var (
 )
 
 func init() {
-	ctrlmetrics.Registry.MustRegister(ResizedTotal, ThresholdReachedTotal, SkippedTotal, MaxCapacityReachedTotal)
+	ctrlmetrics.Registry.MustRegister(ResizedTotal, ThresholdReachedTotal, CriticalThresholdReachedTotal, SkippedTotal, MaxCapacityReachedTotal, QuotaExceededTotal, MonthlyCost, AutoscalerMonthlyCost)
 }
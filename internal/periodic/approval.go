@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+)
+
+// approvalClockSkew is the time by which an approval time may lie in the
+// future, in order to tolerate clock skew between the approving client and the
+// pvc-autoscaler. Approvals further in the future are ignored, since they
+// would not expire in time.
+const approvalClockSkew = time.Minute
+
+// approvalExpiration returns the time for which an approval of a resize is
+// valid for the given [v1alpha1.VolumePolicy].
+func approvalExpiration(policy v1alpha1.VolumePolicy) time.Duration {
+	if policy.ScaleUp.ApprovalExpiration == nil {
+		return common.DefaultApprovalExpiration
+	}
+
+	return policy.ScaleUp.ApprovalExpiration.Duration
+}
+
+// checkApproval checks whether a resize to the target size has been approved
+// via the [common.AnnotationApprovedSize] and [common.AnnotationApprovedAt]
+// annotations on any of the given objects. The approved size has to match the
+// target size exactly and the approval must not be older than the given
+// expiration, nor lie further than [approvalClockSkew] in the future. When the
+// resize has not been approved, the reason and message for the Resizing
+// condition of the PVC are returned.
+func checkApproval(now time.Time, expiration time.Duration, targetSize resource.Quantity, objs ...client.Object) (bool, string, string) {
+	expired, future := false, false
+	for _, obj := range objs {
+		annotations := obj.GetAnnotations()
+		value, ok := annotations[common.AnnotationApprovedSize]
+		if !ok {
+			continue
+		}
+
+		approvedSize, err := resource.ParseQuantity(value)
+		if err != nil || approvedSize.Cmp(targetSize) != 0 {
+			continue
+		}
+
+		approvedAt, err := time.Parse(time.RFC3339, annotations[common.AnnotationApprovedAt])
+		if err != nil {
+			continue
+		}
+
+		if approvedAt.Sub(now) > approvalClockSkew {
+			future = true
+
+			continue
+		}
+
+		if now.Sub(approvedAt) > expiration {
+			expired = true
+
+			continue
+		}
+
+		return true, "", ""
+	}
+
+	if expired {
+		return false, ReasonApprovalExpired, fmt.Sprintf("approval of resize to %s has expired", targetSize.String())
+	}
+
+	if future {
+		return false, ReasonApprovalPending, fmt.Sprintf("approval of resize to %s lies in the future", targetSize.String())
+	}
+
+	return false, ReasonApprovalPending, fmt.Sprintf("waiting for approval of resize to %s", targetSize.String())
+}
+
+// isResizeApproved is a predicate which checks whether the resize of the
+// [corev1.PersistentVolumeClaim] to the target size has been approved on the
+// PVC or on its autoscaler. An approval on the autoscaler applies to all of its
+// PVCs with the approved target size. Pending and expired approvals are
+// reported via the Resizing condition of the PVC, and via an event when the
+// Resizing condition in the given [v1alpha1.VolumeRecommendation] does not
+// report them yet, e.g. because the target size has changed.
+func (r *Runner) isResizeApproved(
+	logger logr.Logger,
+	pvca v1alpha1.Autoscaler,
+	pvc *corev1.PersistentVolumeClaim,
+	policy v1alpha1.VolumePolicy,
+	targetSize resource.Quantity,
+	volumeRecommendation v1alpha1.VolumeRecommendation,
+	resizingConditions *resizingConditionAggregator,
+) bool {
+	approved, reason, message := checkApproval(time.Now(), approvalExpiration(policy), targetSize, pvc, pvca)
+	if approved {
+		return true
+	}
+
+	logger.Info("resize not approved", "reason", reason, "size", targetSize.String())
+	previous := meta.FindStatusCondition(volumeRecommendation.Conditions, string(v1alpha1.ConditionTypeResizing))
+	if previous == nil || previous.Reason != reason || previous.Message != message {
+		r.eventRecorder.Eventf(
+			pvc,
+			corev1.EventTypeNormal,
+			"ResizeApprovalPending",
+			"%s, approve it by annotating the PersistentVolumeClaim or its autoscaler with %s=%s and %s=<RFC 3339 time>",
+			message,
+			common.AnnotationApprovedSize,
+			targetSize.String(),
+			common.AnnotationApprovedAt,
+		)
+	}
+	resizingConditions.addPVCCondition(pvc, metav1.Condition{
+		Type:    string(v1alpha1.ConditionTypeResizing),
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+
+	return false
+}
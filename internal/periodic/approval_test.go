@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+)
+
+var _ = Describe("approvalExpiration", func() {
+	It("should default to the default approval expiration", func() {
+		policy := v1alpha1.VolumePolicy{ScaleUp: &v1alpha1.ScalingRules{}}
+		Expect(approvalExpiration(policy)).To(Equal(common.DefaultApprovalExpiration))
+	})
+
+	It("should return the approval expiration of the policy", func() {
+		policy := v1alpha1.VolumePolicy{ScaleUp: &v1alpha1.ScalingRules{ApprovalExpiration: &metav1.Duration{Duration: time.Hour}}}
+		Expect(approvalExpiration(policy)).To(Equal(time.Hour))
+	})
+})
+
+var _ = Describe("checkApproval", func() {
+	var (
+		now  time.Time
+		pvc  *corev1.PersistentVolumeClaim
+		pvca *v1alpha1.PersistentVolumeClaimAutoscaler
+	)
+
+	approve := func(obj metav1.Object, size string, at time.Time) {
+		obj.SetAnnotations(map[string]string{
+			common.AnnotationApprovedSize: size,
+			common.AnnotationApprovedAt:   at.Format(time.RFC3339),
+		})
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		pvc = &corev1.PersistentVolumeClaim{}
+		pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{}
+	})
+
+	It("should be pending without an approval", func() {
+		approved, reason, message := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeFalse())
+		Expect(reason).To(Equal(ReasonApprovalPending))
+		Expect(message).To(Equal("waiting for approval of resize to 2Gi"))
+	})
+
+	It("should accept an approval on the PVC", func() {
+		approve(pvc, "2Gi", now.Add(-time.Minute))
+
+		approved, _, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeTrue())
+	})
+
+	It("should accept an approval on the PVCA", func() {
+		approve(pvca, "2048Mi", now.Add(-time.Minute))
+
+		approved, _, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeTrue())
+	})
+
+	It("should approve every PVC with the approved target size via an approval on the PVCA", func() {
+		approve(pvca, "2Gi", now.Add(-time.Minute))
+		otherPVC := &corev1.PersistentVolumeClaim{}
+
+		approved, _, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeTrue())
+		approved, _, _ = checkApproval(now, time.Hour, resource.MustParse("2Gi"), otherPVC, pvca)
+		Expect(approved).To(BeTrue())
+		approved, _, _ = checkApproval(now, time.Hour, resource.MustParse("3Gi"), otherPVC, pvca)
+		Expect(approved).To(BeFalse())
+	})
+
+	It("should ignore approvals of a different size", func() {
+		approve(pvc, "3Gi", now.Add(-time.Minute))
+
+		approved, reason, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeFalse())
+		Expect(reason).To(Equal(ReasonApprovalPending))
+	})
+
+	It("should ignore approvals without a valid approval time", func() {
+		pvc.SetAnnotations(map[string]string{common.AnnotationApprovedSize: "2Gi"})
+
+		approved, reason, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeFalse())
+		Expect(reason).To(Equal(ReasonApprovalPending))
+	})
+
+	It("should report expired approvals", func() {
+		approve(pvc, "2Gi", now.Add(-2*time.Hour))
+
+		approved, reason, message := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeFalse())
+		Expect(reason).To(Equal(ReasonApprovalExpired))
+		Expect(message).To(Equal("approval of resize to 2Gi has expired"))
+	})
+
+	It("should ignore approvals in the future", func() {
+		approve(pvc, "2Gi", now.Add(24*time.Hour))
+
+		approved, reason, message := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeFalse())
+		Expect(reason).To(Equal(ReasonApprovalPending))
+		Expect(message).To(Equal("approval of resize to 2Gi lies in the future"))
+	})
+
+	It("should tolerate clock skew of the approval time", func() {
+		approve(pvc, "2Gi", now.Add(approvalClockSkew/2))
+
+		approved, _, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeTrue())
+	})
+
+	It("should prefer a valid approval over an expired one", func() {
+		approve(pvc, "2Gi", now.Add(-2*time.Hour))
+		approve(pvca, "2Gi", now.Add(-time.Minute))
+
+		approved, _, _ := checkApproval(now, time.Hour, resource.MustParse("2Gi"), pvc, pvca)
+		Expect(approved).To(BeTrue())
+	})
+})
+
+var _ = Describe("#isResizeApproved", func() {
+	var (
+		r                  *Runner
+		eventRecorder      *record.FakeRecorder
+		pvc                *corev1.PersistentVolumeClaim
+		pvca               *v1alpha1.PersistentVolumeClaimAutoscaler
+		policy             v1alpha1.VolumePolicy
+		resizingConditions *resizingConditionAggregator
+	)
+
+	BeforeEach(func() {
+		eventRecorder = record.NewFakeRecorder(10)
+		r = &Runner{eventRecorder: eventRecorder}
+		pvc = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "default"}}
+		pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{}
+		policy = v1alpha1.VolumePolicy{ScaleUp: &v1alpha1.ScalingRules{ResizeStrategy: v1alpha1.ManualVolumeResizeStrategy}}
+		resizingConditions = &resizingConditionAggregator{}
+	})
+
+	It("should only report the pending approval via an event when the target size changes", func() {
+		Expect(r.isResizeApproved(logr.Discard(), pvca, pvc, policy, resource.MustParse("2Gi"), v1alpha1.VolumeRecommendation{}, resizingConditions)).To(BeFalse())
+		Expect(eventRecorder.Events).To(Receive(ContainSubstring("waiting for approval of resize to 2Gi")))
+
+		volumeRecommendation := v1alpha1.VolumeRecommendation{Conditions: []metav1.Condition{resizingConditions.pvcConditions[client.ObjectKeyFromObject(pvc)]}}
+		Expect(r.isResizeApproved(logr.Discard(), pvca, pvc, policy, resource.MustParse("2Gi"), volumeRecommendation, resizingConditions)).To(BeFalse())
+		Expect(eventRecorder.Events).NotTo(Receive())
+
+		Expect(r.isResizeApproved(logr.Discard(), pvca, pvc, policy, resource.MustParse("3Gi"), volumeRecommendation, resizingConditions)).To(BeFalse())
+		Expect(eventRecorder.Events).To(Receive(ContainSubstring("waiting for approval of resize to 3Gi")))
+	})
+})
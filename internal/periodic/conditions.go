@@ -6,16 +6,21 @@ package periodic
 
 import (
 	"slices"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
 )
 
 // recommendationsConditionAggregator is a condition aggregator for the RecommendationAvailable condition of the PVCA.
 type recommendationsConditionAggregator struct {
-	conditions []metav1.Condition
+	conditions    []metav1.Condition
+	pvcConditions pvcConditions
 }
 
 // addCondition adds a condition to the aggregator. Only conditions with false status are aggregated.
@@ -25,6 +30,14 @@ func (c *recommendationsConditionAggregator) addCondition(condition metav1.Condi
 	}
 }
 
+// addPVCCondition adds a condition of the given PVC to the aggregator. The
+// condition is kept for the PVC and, prefixed with the name of the PVC,
+// aggregated like the conditions added via addCondition.
+func (c *recommendationsConditionAggregator) addPVCCondition(pvc *corev1.PersistentVolumeClaim, condition metav1.Condition) {
+	c.pvcConditions = c.pvcConditions.set(pvc, condition)
+	c.addCondition(withPVCName(pvc, condition))
+}
+
 // getAggregatedCondition aggregates all conditions into one. If there are no false conditions, it
 // returns a true condition indicating that recommendations have been provided
 func (c *recommendationsConditionAggregator) getAggregatedCondition() metav1.Condition {
@@ -74,7 +87,8 @@ func (c *recommendationsConditionAggregator) getAggregatedCondition() metav1.Con
 
 // resizingConditionAggregator is a condition aggregator for the Resizing condition of the PVCA.
 type resizingConditionAggregator struct {
-	conditions []metav1.Condition
+	conditions    []metav1.Condition
+	pvcConditions pvcConditions
 }
 
 // addCondition adds a condition to the aggregator
@@ -82,6 +96,14 @@ func (c *resizingConditionAggregator) addCondition(condition metav1.Condition) {
 	c.conditions = append(c.conditions, condition)
 }
 
+// addPVCCondition adds a condition of the given PVC to the aggregator. The
+// condition is kept for the PVC and, prefixed with the name of the PVC,
+// aggregated like the conditions added via addCondition.
+func (c *resizingConditionAggregator) addPVCCondition(pvc *corev1.PersistentVolumeClaim, condition metav1.Condition) {
+	c.pvcConditions = c.pvcConditions.set(pvc, condition)
+	c.addCondition(withPVCName(pvc, condition))
+}
+
 // getAggregatedCondition aggregates all conditions into one. If there are no conditions, it returns an empty condition with the
 // Resizing type. If there is one condition with status true, the aggregated condition's status is also true to indicate that there
 // is a resize in progress. If there are only conditions with Unknown status, the aggregated condition will also have Unknown status.
@@ -130,3 +152,125 @@ func (c *resizingConditionAggregator) getAggregatedCondition() metav1.Condition
 		Status:  status,
 	}
 }
+
+// pvcConditions maps PVCs to the latest condition of one type, which has been
+// added for them.
+type pvcConditions map[client.ObjectKey]metav1.Condition
+
+// set sets the condition of the given PVC, replacing the one added before.
+func (c pvcConditions) set(pvc *corev1.PersistentVolumeClaim, condition metav1.Condition) pvcConditions {
+	if c == nil {
+		c = make(pvcConditions)
+	}
+	c[client.ObjectKeyFromObject(pvc)] = condition
+
+	return c
+}
+
+// withPVCName returns a copy of the condition, whose message is prefixed with
+// the name of the given PVC.
+func withPVCName(pvc *corev1.PersistentVolumeClaim, condition metav1.Condition) metav1.Condition {
+	condition.Message = pvc.Name + ": " + condition.Message
+
+	return condition
+}
+
+// setPVCConditions sets the conditions, which have been added for the
+// individual PVCs to the aggregators, on the volume recommendations of the
+// PVCs. Conditions of a type, for which nothing has been added, are removed.
+// PVCs for which no condition has been added at all are left as they are. The
+// conditions are marked with the generation of the autoscaler.
+func setPVCConditions(
+	pvca v1alpha1.Autoscaler,
+	pvcs []*corev1.PersistentVolumeClaim,
+	volumeRecommendations *[]v1alpha1.VolumeRecommendation,
+	recommendationConditions *recommendationsConditionAggregator,
+	resizingConditions *resizingConditionAggregator,
+) {
+	for _, pvc := range pvcs {
+		key := client.ObjectKeyFromObject(pvc)
+		recommendationCondition, hasRecommendationCondition := recommendationConditions.pvcConditions[key]
+		resizingCondition, hasResizingCondition := resizingConditions.pvcConditions[key]
+		if !hasRecommendationCondition && !hasResizingCondition {
+			continue
+		}
+
+		volumeRecommendation := getOrCreateVolumeRecommendationForPVC(*volumeRecommendations, pvc)
+		if pvca.GetNamespace() == "" {
+			volumeRecommendation.Namespace = pvc.Namespace
+		}
+
+		// The conditions are cloned, as they share their backing array with
+		// the status of the PVCA, which must not be modified before it is patched.
+		conditions := slices.Clone(volumeRecommendation.Conditions)
+		if hasRecommendationCondition {
+			recommendationCondition.ObservedGeneration = pvca.GetGeneration()
+			meta.SetStatusCondition(&conditions, recommendationCondition)
+		} else {
+			meta.RemoveStatusCondition(&conditions, string(v1alpha1.ConditionTypeRecommendationAvailable))
+		}
+		if hasResizingCondition {
+			resizingCondition.ObservedGeneration = pvca.GetGeneration()
+			meta.SetStatusCondition(&conditions, resizingCondition)
+		} else {
+			meta.RemoveStatusCondition(&conditions, string(v1alpha1.ConditionTypeResizing))
+		}
+		volumeRecommendation.Conditions = conditions
+
+		setVolumeRecommendationForPVC(volumeRecommendations, pvc, volumeRecommendation)
+	}
+}
+
+// suspendedCondition returns the Suspended condition of the PVCA. When the PVCA
+// is not suspended, it returns an empty condition with the Suspended type, so
+// that the condition is removed from the status of the PVCA.
+func suspendedCondition(pvca v1alpha1.Autoscaler) metav1.Condition {
+	if !pvca.IsSuspended() {
+		return metav1.Condition{Type: string(v1alpha1.ConditionTypeSuspended)}
+	}
+
+	return metav1.Condition{
+		Type:    string(v1alpha1.ConditionTypeSuspended),
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonSuspended,
+		Message: "Resizing is suspended, PersistentVolumeClaims are not patched",
+	}
+}
+
+// pausedCondition returns the Paused condition of the PVCA for the given names
+// of paused PVCs. When no PVC is paused, it returns an empty condition with the
+// Paused type, so that the condition is removed from the status of the PVCA.
+func pausedCondition(pausedPVCs []string) metav1.Condition {
+	if len(pausedPVCs) == 0 {
+		return metav1.Condition{Type: string(v1alpha1.ConditionTypePaused)}
+	}
+
+	pausedPVCs = slices.Sorted(slices.Values(pausedPVCs))
+
+	return metav1.Condition{
+		Type:    string(v1alpha1.ConditionTypePaused),
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonPVCsPaused,
+		Message: "Resizing is paused for PersistentVolumeClaims: " + strings.Join(pausedPVCs, ", "),
+	}
+}
+
+// overlappingCondition returns the Overlapping condition of the PVCA for the
+// given PVCs, which are selected by the PVCA but managed by another autoscaler.
+// When there are no such PVCs, it returns an empty condition with the
+// Overlapping type, so that the condition is removed from the status of the
+// PVCA.
+func overlappingCondition(overlappingPVCs []string) metav1.Condition {
+	if len(overlappingPVCs) == 0 {
+		return metav1.Condition{Type: string(v1alpha1.ConditionTypeOverlapping)}
+	}
+
+	overlappingPVCs = slices.Sorted(slices.Values(overlappingPVCs))
+
+	return metav1.Condition{
+		Type:    string(v1alpha1.ConditionTypeOverlapping),
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonPVCsManagedElsewhere,
+		Message: "PersistentVolumeClaims are managed by other autoscalers: " + strings.Join(overlappingPVCs, ", "),
+	}
+}
@@ -5,8 +5,12 @@
 package periodic
 
 import (
+	"slices"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
@@ -190,3 +194,193 @@ var _ = Describe("resizingConditionAggregator", func() {
 		Expect(got.Message).To(Equal("PersistentVolumeClaims are being resized:\n- pvc-a: resizing\n- pvc-b: resizing\n- pvc-c: resizing"))
 	})
 })
+
+var _ = Describe("suspendedCondition", func() {
+	It("should return an empty condition when the PVCA is not suspended", func() {
+		got := suspendedCondition(&v1alpha1.PersistentVolumeClaimAutoscaler{})
+		Expect(got).To(Equal(metav1.Condition{Type: string(v1alpha1.ConditionTypeSuspended)}))
+	})
+
+	It("should return a true condition when the PVCA is suspended", func() {
+		pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{
+			Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{Suspend: true},
+		}
+
+		got := suspendedCondition(pvca)
+		Expect(got.Type).To(Equal(string(v1alpha1.ConditionTypeSuspended)))
+		Expect(got.Status).To(Equal(metav1.ConditionTrue))
+		Expect(got.Reason).To(Equal(ReasonSuspended))
+	})
+})
+
+var _ = Describe("pausedCondition", func() {
+	It("should return an empty condition when no PVC is paused", func() {
+		Expect(pausedCondition(nil)).To(Equal(metav1.Condition{Type: string(v1alpha1.ConditionTypePaused)}))
+	})
+
+	It("should list the paused PVCs in a sorted order", func() {
+		got := pausedCondition([]string{"pvc-b", "pvc-a"})
+		Expect(got.Type).To(Equal(string(v1alpha1.ConditionTypePaused)))
+		Expect(got.Status).To(Equal(metav1.ConditionTrue))
+		Expect(got.Reason).To(Equal(ReasonPVCsPaused))
+		Expect(got.Message).To(Equal("Resizing is paused for PersistentVolumeClaims: pvc-a, pvc-b"))
+	})
+})
+
+var _ = Describe("overlappingCondition", func() {
+	It("should return an empty condition when no PVC is managed elsewhere", func() {
+		Expect(overlappingCondition(nil)).To(Equal(metav1.Condition{Type: string(v1alpha1.ConditionTypeOverlapping)}))
+	})
+
+	It("should list the PVCs managed by other autoscalers in a sorted order", func() {
+		got := overlappingCondition([]string{"pvc-b (PersistentVolumeClaimAutoscaler/b)", "pvc-a (PersistentVolumeClaimAutoscaler/a)"})
+		Expect(got.Type).To(Equal(string(v1alpha1.ConditionTypeOverlapping)))
+		Expect(got.Status).To(Equal(metav1.ConditionTrue))
+		Expect(got.Reason).To(Equal(ReasonPVCsManagedElsewhere))
+		Expect(got.Message).To(Equal("PersistentVolumeClaims are managed by other autoscalers: pvc-a (PersistentVolumeClaimAutoscaler/a), pvc-b (PersistentVolumeClaimAutoscaler/b)"))
+	})
+})
+
+var _ = Describe("setPVCConditions", func() {
+	var (
+		pvca                     *v1alpha1.PersistentVolumeClaimAutoscaler
+		pvcA                     *corev1.PersistentVolumeClaim
+		pvcB                     *corev1.PersistentVolumeClaim
+		recommendationConditions *recommendationsConditionAggregator
+		resizingConditions       *resizingConditionAggregator
+	)
+
+	BeforeEach(func() {
+		pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pvca"}}
+		pvcA = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pvc-a"}}
+		pvcB = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pvc-b"}}
+		recommendationConditions = &recommendationsConditionAggregator{}
+		resizingConditions = &resizingConditionAggregator{}
+	})
+
+	It("should set the conditions of each PVC and summarize them on the PVCA", func() {
+		recommendationConditions.addPVCCondition(pvcA, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonMetricsFetched,
+			Message: "Recommendation has been provided",
+		})
+		recommendationConditions.addPVCCondition(pvcB, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonMetricsFetchError,
+			Message: "no metrics found",
+		})
+		resizingConditions.addPVCCondition(pvcA, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonReconcile,
+			Message: "resizing from 1Gi to 2Gi",
+		})
+
+		volumeRecommendations := []v1alpha1.VolumeRecommendation{{Name: pvcA.Name}}
+		setPVCConditions(pvca, []*corev1.PersistentVolumeClaim{pvcA, pvcB}, &volumeRecommendations, recommendationConditions, resizingConditions)
+
+		Expect(volumeRecommendations).To(ConsistOf(
+			And(
+				HaveField("Name", pvcA.Name),
+				HaveField("Conditions", ConsistOf(
+					And(
+						HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
+						HaveField("Status", metav1.ConditionTrue),
+					),
+					And(
+						HaveField("Type", string(v1alpha1.ConditionTypeResizing)),
+						HaveField("Status", metav1.ConditionTrue),
+						HaveField("Message", "resizing from 1Gi to 2Gi"),
+						HaveField("LastTransitionTime", Not(BeZero())),
+					),
+				)),
+			),
+			And(
+				HaveField("Name", pvcB.Name),
+				HaveField("Conditions", ConsistOf(And(
+					HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Reason", ReasonMetricsFetchError),
+					HaveField("Message", "no metrics found"),
+				))),
+			),
+		))
+
+		Expect(recommendationConditions.getAggregatedCondition().Message).To(Equal("Recommendations could not be provided for some PersistentVolumeClaims:\n- pvc-b: no metrics found"))
+		Expect(resizingConditions.getAggregatedCondition().Message).To(Equal("PersistentVolumeClaims are being resized:\n- pvc-a: resizing from 1Gi to 2Gi"))
+	})
+
+	It("should remove conditions which are no longer reported and keep the transition time of unchanged ones", func() {
+		transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		volumeRecommendations := []v1alpha1.VolumeRecommendation{{
+			Name: pvcA.Name,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(v1alpha1.ConditionTypeRecommendationAvailable),
+					Status:             metav1.ConditionTrue,
+					Reason:             ReasonMetricsFetched,
+					Message:            "Recommendation has been provided",
+					LastTransitionTime: transitionTime,
+				},
+				{
+					Type:               string(v1alpha1.ConditionTypeResizing),
+					Status:             metav1.ConditionTrue,
+					Reason:             ReasonReconcile,
+					Message:            "resizing from 1Gi to 2Gi",
+					LastTransitionTime: transitionTime,
+				},
+			},
+		}}
+		original := slices.Clone(volumeRecommendations[0].Conditions)
+
+		recommendationConditions.addPVCCondition(pvcA, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonMetricsFetched,
+			Message: "Recommendation has been provided",
+		})
+		setPVCConditions(pvca, []*corev1.PersistentVolumeClaim{pvcA}, &volumeRecommendations, recommendationConditions, resizingConditions)
+
+		Expect(volumeRecommendations[0].Conditions).To(ConsistOf(And(
+			HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
+			HaveField("LastTransitionTime", transitionTime),
+		)))
+		Expect(original).To(HaveLen(2))
+	})
+
+	It("should set the namespace of the PVC for cluster-scoped autoscalers", func() {
+		cpvca := &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "cpvca"}}
+		resizingConditions.addPVCCondition(pvcA, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonPVCResizeCooldown,
+			Message: "cooldown duration has not elapsed yet",
+		})
+
+		var volumeRecommendations []v1alpha1.VolumeRecommendation
+		setPVCConditions(cpvca, []*corev1.PersistentVolumeClaim{pvcA, pvcB}, &volumeRecommendations, recommendationConditions, resizingConditions)
+
+		Expect(volumeRecommendations).To(ConsistOf(And(
+			HaveField("Name", pvcA.Name),
+			HaveField("Namespace", pvcA.Namespace),
+			HaveField("Conditions", ConsistOf(HaveField("Reason", ReasonPVCResizeCooldown))),
+		)))
+	})
+
+	It("should mark the conditions with the generation of the autoscaler", func() {
+		pvca.Generation = 3
+		recommendationConditions.addPVCCondition(pvcA, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonMetricsFetched,
+			Message: "Recommendation has been provided",
+		})
+
+		var volumeRecommendations []v1alpha1.VolumeRecommendation
+		setPVCConditions(pvca, []*corev1.PersistentVolumeClaim{pvcA}, &volumeRecommendations, recommendationConditions, resizingConditions)
+
+		Expect(volumeRecommendations).To(ConsistOf(HaveField("Conditions", ConsistOf(HaveField("ObservedGeneration", int64(3))))))
+	})
+})
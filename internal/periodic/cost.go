@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+	"github.com/gardener/pvc-autoscaler/internal/metrics"
+)
+
+// bytesPerGiB is the number of bytes in one GiB.
+const bytesPerGiB = 1024 * 1024 * 1024
+
+// storagePrice returns the price per GiB-month configured on the StorageClass
+// of the [corev1.PersistentVolumeClaim]. It returns nil if no price is
+// configured.
+func (r *Runner) storagePrice(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (*resource.Quantity, error) {
+	scName := ptr.Deref(pvc.Spec.StorageClassName, "")
+	if scName == "" {
+		return nil, nil
+	}
+
+	var sc storagev1.StorageClass
+	if err := r.client.Get(ctx, types.NamespacedName{Name: scName}, &sc); err != nil {
+		return nil, err
+	}
+
+	value, ok := sc.Annotations[common.AnnotationPricePerGiBMonth]
+	if !ok {
+		return nil, nil
+	}
+
+	price, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation %q on storage class %s: %w", common.AnnotationPricePerGiBMonth, value, scName, err)
+	}
+
+	return &price, nil
+}
+
+// monthlyCost returns the monthly cost of the given size at the given price
+// per GiB-month. The cost is rounded to three decimal places.
+func monthlyCost(size, price *resource.Quantity) *resource.Quantity {
+	if size == nil || price == nil {
+		return nil
+	}
+
+	cost := price.AsApproximateFloat64() * float64(size.Value()) / bytesPerGiB
+
+	return resource.NewMilliQuantity(int64(math.Round(cost*1000)), resource.DecimalSI)
+}
+
+// maxCapacityWithinCost returns the max capacity of the policy, limited to the
+// largest size whose monthly cost at the given price per GiB-month does not
+// exceed the max monthly cost of the policy. The limit never drops below the
+// current size, since PVCs cannot be shrunk, so that PVCs which already exceed
+// the max monthly cost are not resized any further. It also reports whether
+// the max capacity has been limited by the max monthly cost.
+func maxCapacityWithinCost(policy v1alpha1.VolumePolicy, price *resource.Quantity, currSize resource.Quantity) (resource.Quantity, bool) {
+	if policy.MaxMonthlyCost == nil || price == nil || price.Sign() <= 0 {
+		return policy.MaxCapacity, false
+	}
+
+	maxGiB := math.Floor(policy.MaxMonthlyCost.AsApproximateFloat64() / price.AsApproximateFloat64())
+	maxCapacity := resource.NewQuantity(int64(maxGiB)*bytesPerGiB, resource.BinarySI)
+	if maxCapacity.Cmp(currSize) < 0 {
+		maxCapacity = &currSize
+	}
+	if maxCapacity.Cmp(policy.MaxCapacity) < 0 {
+		return *maxCapacity, true
+	}
+
+	return policy.MaxCapacity, false
+}
+
+// setMonthlyCosts updates the current and projected monthly cost of the
+// [v1alpha1.VolumeRecommendation] items and reports them, together with the
+// totals of the [v1alpha1.Autoscaler], as metrics. PVCs
+// which have not been processed in this cycle are not present in prices and
+// keep their previous costs. A nil price clears the costs. It returns the keys
+// of the PVCs whose costs have been reported.
+func setMonthlyCosts(pvca v1alpha1.Autoscaler, volumeRecommendations []v1alpha1.VolumeRecommendation, prices map[client.ObjectKey]*resource.Quantity) []client.ObjectKey {
+	var currentTotal, targetTotal float64
+
+	pvcKeys := make([]client.ObjectKey, 0, len(volumeRecommendations))
+
+	for i := range volumeRecommendations {
+		volumeRecommendation := &volumeRecommendations[i]
+		pvcKey := client.ObjectKey{Namespace: cmp.Or(volumeRecommendation.Namespace, pvca.GetNamespace()), Name: volumeRecommendation.Name}
+		if price, ok := prices[pvcKey]; ok {
+			volumeRecommendation.Current.MonthlyCost = monthlyCost(volumeRecommendation.Current.Size, price)
+			volumeRecommendation.Target.MonthlyCost = monthlyCost(volumeRecommendation.Target.Size, price)
+		}
+
+		pvcKeys = append(pvcKeys, pvcKey)
+
+		if cost := volumeRecommendation.Current.MonthlyCost; cost != nil {
+			metrics.MonthlyCost.WithLabelValues(pvcKey.Namespace, pvcKey.Name, "current").Set(cost.AsApproximateFloat64())
+			currentTotal += cost.AsApproximateFloat64()
+		} else {
+			metrics.MonthlyCost.DeleteLabelValues(pvcKey.Namespace, pvcKey.Name, "current")
+		}
+
+		if cost := volumeRecommendation.Target.MonthlyCost; cost != nil {
+			metrics.MonthlyCost.WithLabelValues(pvcKey.Namespace, pvcKey.Name, "target").Set(cost.AsApproximateFloat64())
+			targetTotal += cost.AsApproximateFloat64()
+		} else {
+			metrics.MonthlyCost.DeleteLabelValues(pvcKey.Namespace, pvcKey.Name, "target")
+		}
+	}
+
+	metrics.AutoscalerMonthlyCost.WithLabelValues(pvca.GetNamespace(), pvca.GetName(), "current").Set(currentTotal)
+	metrics.AutoscalerMonthlyCost.WithLabelValues(pvca.GetNamespace(), pvca.GetName(), "target").Set(targetTotal)
+
+	return pvcKeys
+}
+
+// updateReportedCosts records the PVCs whose monthly costs have been reported
+// for the autoscaler with the given key, and deletes the cost metrics of the
+// PVCs which were reported before, but are not managed by the autoscaler
+// anymore. A nil pvcKeys deletes all cost metrics of the autoscaler, which has
+// been removed.
+func (r *Runner) updateReportedCosts(key client.ObjectKey, pvcKeys []client.ObjectKey) {
+	for _, pvcKey := range r.reportedCosts[key] {
+		if !slices.Contains(pvcKeys, pvcKey) {
+			metrics.MonthlyCost.DeleteLabelValues(pvcKey.Namespace, pvcKey.Name, "current")
+			metrics.MonthlyCost.DeleteLabelValues(pvcKey.Namespace, pvcKey.Name, "target")
+		}
+	}
+
+	if pvcKeys == nil {
+		delete(r.reportedCosts, key)
+		metrics.AutoscalerMonthlyCost.DeleteLabelValues(key.Namespace, key.Name, "current")
+		metrics.AutoscalerMonthlyCost.DeleteLabelValues(key.Namespace, key.Name, "target")
+
+		return
+	}
+
+	r.reportedCosts[key] = pvcKeys
+}
+
+// recordMaxMonthlyCostReached reports that the [corev1.PersistentVolumeClaim]
+// is not resized, because its max capacity has been limited by the max monthly
+// cost of the policy and has been reached.
+func (r *Runner) recordMaxMonthlyCostReached(logger logr.Logger, pvc *corev1.PersistentVolumeClaim, policy v1alpha1.VolumePolicy, resizingConditions *resizingConditionAggregator) {
+	r.eventRecorder.Eventf(
+		pvc,
+		corev1.EventTypeWarning,
+		ReasonMaxMonthlyCostReached,
+		"max monthly cost (%s) has been reached at %s",
+		policy.MaxMonthlyCost.String(),
+		policy.MaxCapacity.String(),
+	)
+	logger.Info("max monthly cost reached")
+
+	if policy.ScaleUp.ResizeStrategy != v1alpha1.OffVolumeResizeStrategy {
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonMaxMonthlyCostReached,
+			Message: fmt.Sprintf("max monthly cost (%s) reached", policy.MaxMonthlyCost.String()),
+		})
+	}
+}
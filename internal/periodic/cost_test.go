@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/metrics"
+)
+
+var _ = Describe("monthlyCost", func() {
+	It("should return nil when no price is known", func() {
+		Expect(monthlyCost(ptr.To(resource.MustParse("10Gi")), nil)).To(BeNil())
+	})
+
+	It("should compute the cost from the price per GiB-month", func() {
+		Expect(monthlyCost(ptr.To(resource.MustParse("10Gi")), ptr.To(resource.MustParse("0.1"))).String()).To(Equal("1"))
+		Expect(monthlyCost(ptr.To(resource.MustParse("1536Mi")), ptr.To(resource.MustParse("0.25"))).String()).To(Equal("375m"))
+	})
+})
+
+var _ = Describe("maxCapacityWithinCost", func() {
+	var (
+		policy   v1alpha1.VolumePolicy
+		currSize resource.Quantity
+	)
+
+	BeforeEach(func() {
+		policy = v1alpha1.VolumePolicy{MaxCapacity: resource.MustParse("100Gi")}
+		currSize = resource.MustParse("10Gi")
+	})
+
+	It("should return the max capacity when no max monthly cost is configured", func() {
+		maxCapacity, costLimited := maxCapacityWithinCost(policy, ptr.To(resource.MustParse("0.1")), currSize)
+		Expect(maxCapacity).To(Equal(policy.MaxCapacity))
+		Expect(costLimited).To(BeFalse())
+	})
+
+	It("should return the max capacity when no price is known", func() {
+		policy.MaxMonthlyCost = ptr.To(resource.MustParse("5"))
+		maxCapacity, costLimited := maxCapacityWithinCost(policy, nil, currSize)
+		Expect(maxCapacity).To(Equal(policy.MaxCapacity))
+		Expect(costLimited).To(BeFalse())
+	})
+
+	It("should limit the max capacity to the max monthly cost", func() {
+		policy.MaxMonthlyCost = ptr.To(resource.MustParse("5"))
+		maxCapacity, costLimited := maxCapacityWithinCost(policy, ptr.To(resource.MustParse("0.3")), currSize)
+		Expect(maxCapacity.String()).To(Equal("16Gi"))
+		Expect(costLimited).To(BeTrue())
+	})
+
+	It("should not limit the max capacity below the current size", func() {
+		policy.MaxMonthlyCost = ptr.To(resource.MustParse("0.5"))
+		maxCapacity, costLimited := maxCapacityWithinCost(policy, ptr.To(resource.MustParse("0.6")), currSize)
+		Expect(maxCapacity).To(Equal(currSize))
+		Expect(costLimited).To(BeTrue())
+	})
+
+	It("should keep the max capacity when it is below the max monthly cost", func() {
+		policy.MaxMonthlyCost = ptr.To(resource.MustParse("500"))
+		maxCapacity, costLimited := maxCapacityWithinCost(policy, ptr.To(resource.MustParse("0.3")), currSize)
+		Expect(maxCapacity).To(Equal(policy.MaxCapacity))
+		Expect(costLimited).To(BeFalse())
+	})
+})
+
+var _ = Describe("setMonthlyCosts", func() {
+	It("should only update the costs of PVCs with a known price", func() {
+		pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "pvca", Namespace: "default"}}
+		volumeRecommendations := []v1alpha1.VolumeRecommendation{
+			{
+				Name:    "priced",
+				Current: v1alpha1.CurrentVolumeStatus{Size: ptr.To(resource.MustParse("10Gi"))},
+				Target:  v1alpha1.TargetRecommendation{Size: ptr.To(resource.MustParse("20Gi"))},
+			},
+			{
+				Name:    "unpriced",
+				Current: v1alpha1.CurrentVolumeStatus{Size: ptr.To(resource.MustParse("10Gi")), MonthlyCost: ptr.To(resource.MustParse("3"))},
+			},
+			{
+				Name:    "skipped",
+				Current: v1alpha1.CurrentVolumeStatus{Size: ptr.To(resource.MustParse("10Gi")), MonthlyCost: ptr.To(resource.MustParse("2"))},
+			},
+		}
+
+		setMonthlyCosts(pvca, volumeRecommendations, map[client.ObjectKey]*resource.Quantity{
+			{Namespace: "default", Name: "priced"}:   ptr.To(resource.MustParse("0.1")),
+			{Namespace: "default", Name: "unpriced"}: nil,
+		})
+
+		Expect(volumeRecommendations[0].Current.MonthlyCost.String()).To(Equal("1"))
+		Expect(volumeRecommendations[0].Target.MonthlyCost.String()).To(Equal("2"))
+		Expect(volumeRecommendations[1].Current.MonthlyCost).To(BeNil())
+		Expect(volumeRecommendations[2].Current.MonthlyCost.String()).To(Equal("2"))
+	})
+})
+
+var _ = Describe("#updateReportedCosts", func() {
+	var (
+		r        *Runner
+		pvca     *v1alpha1.PersistentVolumeClaimAutoscaler
+		pvcaKey  client.ObjectKey
+		keptKey  client.ObjectKey
+		movedKey client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		r = &Runner{reportedCosts: make(map[client.ObjectKey][]client.ObjectKey)}
+		pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "costs", Namespace: "default"}}
+		pvcaKey = client.ObjectKeyFromObject(pvca)
+		keptKey = client.ObjectKey{Namespace: "default", Name: "kept"}
+		movedKey = client.ObjectKey{Namespace: "default", Name: "moved"}
+
+		recommendations := []v1alpha1.VolumeRecommendation{
+			{Name: keptKey.Name, Current: v1alpha1.CurrentVolumeStatus{Size: ptr.To(resource.MustParse("10Gi"))}},
+			{Name: movedKey.Name, Current: v1alpha1.CurrentVolumeStatus{Size: ptr.To(resource.MustParse("10Gi"))}},
+		}
+		prices := map[client.ObjectKey]*resource.Quantity{
+			keptKey:  ptr.To(resource.MustParse("0.1")),
+			movedKey: ptr.To(resource.MustParse("0.1")),
+		}
+		r.updateReportedCosts(pvcaKey, setMonthlyCosts(pvca, recommendations, prices))
+	})
+
+	It("should delete the cost metrics of PVCs which are not reported anymore", func() {
+		recommendations := []v1alpha1.VolumeRecommendation{
+			{Name: keptKey.Name, Current: v1alpha1.CurrentVolumeStatus{Size: ptr.To(resource.MustParse("10Gi"))}},
+		}
+		r.updateReportedCosts(pvcaKey, setMonthlyCosts(pvca, recommendations, map[client.ObjectKey]*resource.Quantity{keptKey: ptr.To(resource.MustParse("0.1"))}))
+
+		Expect(metrics.MonthlyCost.DeleteLabelValues(movedKey.Namespace, movedKey.Name, "current")).To(BeFalse())
+		Expect(metrics.MonthlyCost.DeleteLabelValues(keptKey.Namespace, keptKey.Name, "current")).To(BeTrue())
+		Expect(r.reportedCosts).To(HaveKeyWithValue(pvcaKey, ConsistOf(keptKey)))
+	})
+
+	It("should delete all cost metrics of a removed autoscaler", func() {
+		r.updateReportedCosts(pvcaKey, nil)
+
+		Expect(metrics.MonthlyCost.DeleteLabelValues(keptKey.Namespace, keptKey.Name, "current")).To(BeFalse())
+		Expect(metrics.MonthlyCost.DeleteLabelValues(movedKey.Namespace, movedKey.Name, "current")).To(BeFalse())
+		Expect(metrics.AutoscalerMonthlyCost.DeleteLabelValues(pvcaKey.Namespace, pvcaKey.Name, "current")).To(BeFalse())
+		Expect(r.reportedCosts).NotTo(HaveKey(pvcaKey))
+	})
+})
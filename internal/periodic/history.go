@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+// Scaling reasons returned by [Runner.shouldResizePVC].
+const (
+	scalingReasonSpace  = "passing storage threshold"
+	scalingReasonInodes = "passing inodes threshold"
+)
+
+// resizeTrigger returns the [v1alpha1.ResizeTrigger] for the given scaling
+// reason.
+func resizeTrigger(scalingReason string) v1alpha1.ResizeTrigger {
+	if scalingReason == scalingReasonInodes {
+		return v1alpha1.ResizeTriggerInodes
+	}
+
+	return v1alpha1.ResizeTriggerSpace
+}
+
+// latestScalingReason returns the scaling reason of the latest resize in the
+// resize history of the [v1alpha1.VolumeRecommendation]. It describes a resize
+// in progress, when the PVC has not passed a threshold in the current
+// reconciliation, e.g. because the metrics have not been fetched for it. It is
+// empty, when the PVC has not been resized yet.
+func latestScalingReason(volumeRecommendation v1alpha1.VolumeRecommendation) string {
+	history := volumeRecommendation.ResizeHistory
+	if len(history) == 0 {
+		return ""
+	}
+
+	switch history[len(history)-1].Trigger {
+	case v1alpha1.ResizeTriggerSpace:
+		return scalingReasonSpace
+	case v1alpha1.ResizeTriggerInodes:
+		return scalingReasonInodes
+	case v1alpha1.ResizeTriggerPredictive:
+		return "predicted utilization"
+	case v1alpha1.ResizeTriggerManual:
+		return "resize request"
+	case v1alpha1.ResizeTriggerUniformScaling:
+		return "uniform scaling"
+	default:
+		return ""
+	}
+}
+
+// recordResize appends an entry for a resize of the PVC from the given size to
+// the given size to the resize history of the [v1alpha1.VolumeRecommendation].
+// The oldest entries are dropped, so that at most
+// [v1alpha1.ResizeHistoryLimit] entries are kept.
+func recordResize(
+	volumeRecommendation *v1alpha1.VolumeRecommendation,
+	from, to resource.Quantity,
+	trigger v1alpha1.ResizeTrigger,
+	outcome v1alpha1.ResizeOutcome,
+	message string,
+) {
+	entry := v1alpha1.ResizeHistoryEntry{
+		Time:              metav1.Now(),
+		From:              from,
+		To:                to,
+		Trigger:           trigger,
+		UsedSpacePercent:  volumeRecommendation.Current.UsedSpacePercent,
+		UsedInodesPercent: volumeRecommendation.Current.UsedInodesPercent,
+		Outcome:           outcome,
+		Message:           message,
+	}
+
+	// The history is copied, as it shares its backing array with the status
+	// of the PVCA, which must not be modified before it is patched.
+	history := make([]v1alpha1.ResizeHistoryEntry, 0, v1alpha1.ResizeHistoryLimit)
+	if overflow := len(volumeRecommendation.ResizeHistory) + 1 - v1alpha1.ResizeHistoryLimit; overflow > 0 {
+		history = append(history, volumeRecommendation.ResizeHistory[overflow:]...)
+	} else {
+		history = append(history, volumeRecommendation.ResizeHistory...)
+	}
+	volumeRecommendation.ResizeHistory = append(history, entry)
+}
+
+// completeResize sets the outcome of the latest resize of the PVC, once it is
+// no longer in progress. The resize has succeeded when the capacity of the PVC
+// has reached the requested size.
+func completeResize(pvc *corev1.PersistentVolumeClaim, volumeRecommendation *v1alpha1.VolumeRecommendation) {
+	history := volumeRecommendation.ResizeHistory
+	if len(history) == 0 || history[len(history)-1].Outcome != v1alpha1.ResizeOutcomeInProgress {
+		return
+	}
+
+	// The history is cloned for the same reason as in recordResize.
+	history = slices.Clone(history)
+	latest := &history[len(history)-1]
+	latest.Outcome = v1alpha1.ResizeOutcomeSucceeded
+	if pvc.Status.Capacity.Storage().Cmp(latest.To) < 0 {
+		latest.Outcome = v1alpha1.ResizeOutcomeFailed
+	}
+	volumeRecommendation.ResizeHistory = history
+}
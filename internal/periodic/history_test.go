@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+var _ = Describe("resizeTrigger", func() {
+	It("should map the scaling reasons to triggers", func() {
+		Expect(resizeTrigger(scalingReasonSpace)).To(Equal(v1alpha1.ResizeTriggerSpace))
+		Expect(resizeTrigger(scalingReasonInodes)).To(Equal(v1alpha1.ResizeTriggerInodes))
+	})
+})
+
+var _ = Describe("latestScalingReason", func() {
+	It("should return the scaling reason of the latest resize", func() {
+		volumeRecommendation := v1alpha1.VolumeRecommendation{ResizeHistory: []v1alpha1.ResizeHistoryEntry{
+			{Trigger: v1alpha1.ResizeTriggerSpace},
+			{Trigger: v1alpha1.ResizeTriggerInodes},
+		}}
+		Expect(latestScalingReason(volumeRecommendation)).To(Equal(scalingReasonInodes))
+
+		volumeRecommendation.ResizeHistory = append(volumeRecommendation.ResizeHistory, v1alpha1.ResizeHistoryEntry{Trigger: v1alpha1.ResizeTriggerUniformScaling})
+		Expect(latestScalingReason(volumeRecommendation)).To(Equal("uniform scaling"))
+	})
+
+	It("should return an empty reason when the PVC has not been resized", func() {
+		Expect(latestScalingReason(v1alpha1.VolumeRecommendation{})).To(BeEmpty())
+	})
+})
+
+var _ = Describe("recordResize", func() {
+	It("should record the utilization at the time of the resize", func() {
+		volumeRecommendation := &v1alpha1.VolumeRecommendation{
+			Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(91), UsedInodesPercent: ptr.To(12)},
+		}
+
+		recordResize(volumeRecommendation, resource.MustParse("1Gi"), resource.MustParse("2Gi"), v1alpha1.ResizeTriggerSpace, v1alpha1.ResizeOutcomeInProgress, "capped by ResourceQuota default/quota")
+
+		Expect(volumeRecommendation.ResizeHistory).To(ConsistOf(And(
+			HaveField("Time.IsZero()", BeFalse()),
+			HaveField("From", resource.MustParse("1Gi")),
+			HaveField("To", resource.MustParse("2Gi")),
+			HaveField("Trigger", v1alpha1.ResizeTriggerSpace),
+			HaveField("UsedSpacePercent", Equal(ptr.To(91))),
+			HaveField("UsedInodesPercent", Equal(ptr.To(12))),
+			HaveField("Outcome", v1alpha1.ResizeOutcomeInProgress),
+			HaveField("Message", "capped by ResourceQuota default/quota"),
+		)))
+	})
+
+	It("should drop the oldest entries when the limit is exceeded", func() {
+		volumeRecommendation := &v1alpha1.VolumeRecommendation{}
+		for i := range v1alpha1.ResizeHistoryLimit + 2 {
+			from := *resource.NewQuantity(int64(i+1)*bytesPerGiB, resource.BinarySI)
+			to := *resource.NewQuantity(int64(i+2)*bytesPerGiB, resource.BinarySI)
+			recordResize(volumeRecommendation, from, to, v1alpha1.ResizeTriggerSpace, v1alpha1.ResizeOutcomeSucceeded, "")
+		}
+
+		Expect(volumeRecommendation.ResizeHistory).To(HaveLen(v1alpha1.ResizeHistoryLimit))
+		Expect(volumeRecommendation.ResizeHistory[0].From.String()).To(Equal("3Gi"))
+		Expect(volumeRecommendation.ResizeHistory[v1alpha1.ResizeHistoryLimit-1].To.String()).To(Equal("13Gi"))
+	})
+
+	It("should not modify the history it has been given", func() {
+		history := make([]v1alpha1.ResizeHistoryEntry, 1, v1alpha1.ResizeHistoryLimit)
+		volumeRecommendation := &v1alpha1.VolumeRecommendation{ResizeHistory: history}
+
+		recordResize(volumeRecommendation, resource.MustParse("1Gi"), resource.MustParse("2Gi"), v1alpha1.ResizeTriggerSpace, v1alpha1.ResizeOutcomeInProgress, "")
+
+		Expect(volumeRecommendation.ResizeHistory).To(HaveLen(2))
+		Expect(history[:2][1].Outcome).To(BeEmpty())
+	})
+})
+
+var _ = Describe("completeResize", func() {
+	var (
+		pvc                  *corev1.PersistentVolumeClaim
+		volumeRecommendation *v1alpha1.VolumeRecommendation
+	)
+
+	BeforeEach(func() {
+		pvc = &corev1.PersistentVolumeClaim{
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+			},
+		}
+		volumeRecommendation = &v1alpha1.VolumeRecommendation{
+			ResizeHistory: []v1alpha1.ResizeHistoryEntry{
+				{To: resource.MustParse("2Gi"), Outcome: v1alpha1.ResizeOutcomeInProgress},
+			},
+		}
+	})
+
+	It("should mark the resize as succeeded when the capacity has reached the requested size", func() {
+		completeResize(pvc, volumeRecommendation)
+		Expect(volumeRecommendation.ResizeHistory[0].Outcome).To(Equal(v1alpha1.ResizeOutcomeSucceeded))
+	})
+
+	It("should mark the resize as failed when the capacity is below the requested size", func() {
+		pvc.Status.Capacity[corev1.ResourceStorage] = resource.MustParse("1Gi")
+		completeResize(pvc, volumeRecommendation)
+		Expect(volumeRecommendation.ResizeHistory[0].Outcome).To(Equal(v1alpha1.ResizeOutcomeFailed))
+	})
+
+	It("should not change completed resizes", func() {
+		volumeRecommendation.ResizeHistory[0].Outcome = v1alpha1.ResizeOutcomeFailed
+		completeResize(pvc, volumeRecommendation)
+		Expect(volumeRecommendation.ResizeHistory[0].Outcome).To(Equal(v1alpha1.ResizeOutcomeFailed))
+	})
+})
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StorageClassIndexKey is the index key for the StorageClass of
+// PersistentVolumeClaims, which is used for computing the storage budget of a
+// StorageClass.
+const StorageClassIndexKey = ".spec.storageClassName"
+
+// AddStorageClassFieldIndexer adds an index for the StorageClass of
+// PersistentVolumeClaims to the given indexer.
+func AddStorageClassFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &corev1.PersistentVolumeClaim{}, StorageClassIndexKey, storageClassIndexFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to PersistentVolumeClaim Informer: %w", StorageClassIndexKey, err)
+	}
+
+	return nil
+}
+
+// storageClassIndexFunc returns the StorageClass of the given
+// [corev1.PersistentVolumeClaim] for the [StorageClassIndexKey] index.
+func storageClassIndexFunc(obj client.Object) []string {
+	pvc, ok := obj.(*corev1.PersistentVolumeClaim)
+	if !ok {
+		return nil
+	}
+
+	return []string{ptr.Deref(pvc.Spec.StorageClassName, "")}
+}
@@ -5,16 +5,19 @@
 package periodic
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
-	"path"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -78,8 +81,8 @@ const (
 	ReasonPVCFetchError = "PersistentVolumeClaimFetchError"
 	// ReasonNoPVCsMatched indicates that pods were found but none had PVC volumes matching the policy.
 	ReasonNoPVCsMatched = "NoPersistentVolumeClaimsMatched"
-	// ReasonAmbiguousPVCA indicates that a PVC is autoscaled by multiple PVCAs.
-	ReasonAmbiguousPVCA = "AmbiguousPersistentVolumeClaimAutoscaler"
+	// ReasonTargetNotFound indicates that a target of the PVCA does not exist, while the PVCs of its other targets are still managed.
+	ReasonTargetNotFound = "TargetNotFound"
 	// ReasonRecommendationError indicates an error occurred during recommendation computation.
 	ReasonRecommendationError = "RecommendationError"
 	// ReasonRecommendationsProvided indicates that all recommendations have been computed and added to the status.
@@ -90,11 +93,29 @@ const (
 	ReasonReconcile = "Reconcile"
 	// ReasonPVCResizeCooldown indicates that the PVC resize is in cooldown period.
 	ReasonPVCResizeCooldown = "PersistentVolumeClaimResizeCooldown"
+	// ReasonUniformScaling indicates that PVCs are resized to keep all PVCs matched by a volume policy at the same size.
+	ReasonUniformScaling = "UniformScaling"
+	// ReasonPVCResizeStabilization indicates that the PVC resize is waiting for the stabilization window to elapse.
+	ReasonPVCResizeStabilization = "PersistentVolumeClaimResizeStabilization"
+	// ReasonQuotaExceeded indicates that a PVC resize was capped or skipped, because it would exceed a ResourceQuota or storage budget.
+	ReasonQuotaExceeded = "QuotaExceeded"
+	// ReasonSuspended indicates that resizing is suspended via the spec of the PVCA.
+	ReasonSuspended = "Suspended"
+	// ReasonPVCsPaused indicates that resizing is paused for some PVCs via the [common.AnnotationPaused] annotation.
+	ReasonPVCsPaused = "PersistentVolumeClaimsPaused"
+	// ReasonApprovalPending indicates that a resize with the Manual resize strategy waits for approval.
+	ReasonApprovalPending = "ApprovalPending"
+	// ReasonApprovalExpired indicates that the approval of a resize with the Manual resize strategy has expired.
+	ReasonApprovalExpired = "ApprovalExpired"
+	// ReasonPVCsManagedElsewhere indicates that some PVCs selected by the autoscaler are managed by another autoscaler, which takes precedence.
+	ReasonPVCsManagedElsewhere = "PersistentVolumeClaimsManagedElsewhere"
+	// ReasonMaxMonthlyCostReached indicates that a PVC is not resized, because it would exceed the max monthly cost of its volume policy.
+	ReasonMaxMonthlyCostReached = "MaxMonthlyCostReached"
 )
 
 // Runner is a [sigs.k8s.io/controller-runtime/pkg/manager.Runnable], which
-// processes [v1alpha1.PersistentVolumeClaimAutoscaler] items on a regular basis
-// and performs PVC resizing when thresholds are reached.
+// processes [v1alpha1.PersistentVolumeClaimAutoscaler] items at their check
+// interval and performs PVC resizing when thresholds are reached.
 type Runner struct {
 	client         client.Client
 	interval       time.Duration
@@ -103,8 +124,46 @@ type Runner struct {
 	pvcFetcher     pvcfetcher.Fetcher
 	heartbeat      *healthcheck.Heartbeat
 	autoscalerName string
+	budgets        *budgetTracker
+	resizeRequests *resizeRequestTracker
+
+	// pvcTargets maps the PVCs of an autoscaler to the target managing them.
+	// It is populated when fetching the PVCs in every reconciliation cycle.
+	pvcTargets map[v1alpha1.Autoscaler]map[client.ObjectKey]autoscalingv1.CrossVersionObjectReference
+	// missingTargets maps an autoscaler to its targets, which do not exist.
+	// It is populated when fetching the PVCs in every reconciliation cycle.
+	missingTargets map[v1alpha1.Autoscaler][]autoscalingv1.CrossVersionObjectReference
+	// overlappingPVCs maps an autoscaler to the PVCs it selects, which are
+	// managed by another autoscaler taking precedence. It is populated when
+	// resolving the ownership of the PVCs in every reconciliation cycle.
+	overlappingPVCs map[v1alpha1.Autoscaler][]string
+
+	// mu serializes the periodic and the event-driven reconciliation, which
+	// share the state of the reconciliation cycle.
+	mu sync.Mutex
+	// metricsData are the metrics fetched by the latest periodic
+	// reconciliation, which are reused by the event-driven reconciliation.
+	metricsData metricssource.Metrics
+	// pvcIndex maps the PVCs to the autoscaler managing them, in order to
+	// find the autoscalers affected by an event.
+	pvcIndex *pvcIndex
+	// schedule holds the next check of every autoscaler, which is due after
+	// the check interval of the autoscaler has elapsed.
+	schedule *schedule
+	// wakeup notifies the periodic reconciliation about changes of the
+	// schedule, which have not been made by itself.
+	wakeup chan struct{}
+	// reportedCosts maps the autoscalers to the PVCs, whose monthly costs
+	// have been reported as metrics, in order to delete the metrics of the
+	// PVCs and autoscalers which have been removed.
+	reportedCosts map[client.ObjectKey][]client.ObjectKey
 }
 
+// checkBatchWindow is the time within which the checks of autoscalers, which
+// are due at almost the same time, are batched together, so that the metrics
+// are fetched once for all of them.
+const checkBatchWindow = 5 * time.Second
+
 var _ manager.Runnable = &Runner{}
 
 // Option is a function which configures the [Runner].
@@ -133,6 +192,13 @@ func New(opts ...Option) (*Runner, error) {
 		return nil, ErrNoPVCFetcher
 	}
 
+	r.budgets = newBudgetTracker(r.client)
+	r.resizeRequests = newResizeRequestTracker(r.client)
+	r.pvcIndex = newPVCIndex()
+	r.schedule = newSchedule()
+	r.wakeup = make(chan struct{}, 1)
+	r.reportedCosts = make(map[client.ObjectKey][]client.ObjectKey)
+
 	return r, nil
 }
 
@@ -145,7 +211,8 @@ func WithClient(c client.Client) Option {
 	return opt
 }
 
-// WithInterval configures the [Runner] with the given interval.
+// WithInterval configures the [Runner] with the given interval, which is the
+// default check interval of the autoscalers.
 func WithInterval(interval time.Duration) Option {
 	opt := func(r *Runner) {
 		r.interval = interval
@@ -205,9 +272,9 @@ func WithAutoscalerName(name string) Option {
 // Start implements the
 // [sigs.k8s.io/controller-runtime/pkg/manager.Runnable] interface.
 func (r *Runner) Start(ctx context.Context) error {
-	ticker := time.NewTicker(r.interval)
+	timer := time.NewTimer(r.interval)
 	logger := log.FromContext(ctx, "controller", common.ControllerName)
-	defer ticker.Stop()
+	defer timer.Stop()
 
 	if r.heartbeat != nil {
 		r.heartbeat.StartMonitoring()
@@ -215,74 +282,272 @@ func (r *Runner) Start(ctx context.Context) error {
 
 	for {
 		select {
-		case <-ticker.C:
-			if err := r.reconcileAll(ctx); err != nil {
+		case <-timer.C:
+			if err := r.reconcileDue(ctx); err != nil {
 				logger.Error(err, "failed to reconcile persistentvolumeclaimautoscalers")
 			}
 
 			if r.heartbeat != nil {
 				r.heartbeat.UpdateLastActivity()
 			}
+
+			timer.Reset(r.untilNextCheck())
+		case <-r.wakeup:
+			timer.Reset(r.untilNextCheck())
 		case <-ctx.Done():
 			return nil
 		}
 	}
 }
 
-// reconcileAll processes all [v1alpha1.PersistentVolumeClaimAutoscaler]
-// resources.
+// untilNextCheck returns the duration until the earliest scheduled check. The
+// [Runner] wakes up at least once per interval, in order to pick up new
+// autoscalers and to report its activity.
+func (r *Runner) untilNextCheck() time.Duration {
+	wait := r.interval
+	if next, ok := r.schedule.next(); ok {
+		wait = min(wait, max(time.Until(next), 0))
+	}
+
+	return wait
+}
+
+// wake makes the periodic reconciliation recompute the time until the
+// earliest scheduled check.
+func (r *Runner) wake() {
+	select {
+	case r.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// checkInterval returns the interval at which the PVCs of the given
+// [v1alpha1.Autoscaler] are checked. Check intervals below
+// [v1alpha1.MinCheckInterval] are ignored.
+func (r *Runner) checkInterval(pvca v1alpha1.Autoscaler) time.Duration {
+	if obj, ok := pvca.(*v1alpha1.PersistentVolumeClaimAutoscaler); ok && obj.Spec.CheckInterval != nil && obj.Spec.CheckInterval.Duration >= v1alpha1.MinCheckInterval {
+		return obj.Spec.CheckInterval.Duration
+	}
+
+	return r.interval
+}
+
+// reconcileDue processes the autoscalers, whose next check is due within the
+// [checkBatchWindow], and the autoscalers which have not been scheduled yet.
+func (r *Runner) reconcileDue(ctx context.Context) error {
+	deadline := time.Now().Add(checkBatchWindow)
+
+	return r.reconcile(ctx, func(pvca v1alpha1.Autoscaler) bool {
+		at, ok := r.schedule.get(client.ObjectKeyFromObject(pvca))
+
+		return !ok || !at.After(deadline)
+	})
+}
+
+// reconcileAll processes all autoscalers regardless of their next check.
 func (r *Runner) reconcileAll(ctx context.Context) error {
-	var (
-		logger   = log.FromContext(ctx, "controller", common.ControllerName)
-		pvcaList v1alpha1.PersistentVolumeClaimAutoscalerList
-	)
+	return r.reconcile(ctx, func(v1alpha1.Autoscaler) bool { return true })
+}
+
+// reconcile processes the [v1alpha1.PersistentVolumeClaimAutoscaler] and
+// [v1alpha1.ClusterPersistentVolumeClaimAutoscaler] resources, for which isDue
+// returns true. The metrics are fetched once for all of them and their next
+// check is scheduled after their check interval.
+func (r *Runner) reconcile(ctx context.Context, isDue func(v1alpha1.Autoscaler) bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	if err := r.client.List(ctx, &pvcaList, client.MatchingFields{v1alpha1.AutoscalerNameIndexKey: r.autoscalerName}); err != nil {
+	logger := log.FromContext(ctx, "controller", common.ControllerName)
+
+	autoscalers, err := r.listAutoscalers(ctx)
+	if err != nil {
 		return err
 	}
+	r.forgetAutoscalers(autoscalers)
+
+	others, err := r.listOtherAutoscalers(ctx)
+	if err != nil {
+		return err
+	}
+
+	due := slices.DeleteFunc(slices.Clone(autoscalers), func(pvca v1alpha1.Autoscaler) bool {
+		return !isDue(pvca)
+	})
 
 	// Nothing to do for now
-	if len(pvcaList.Items) == 0 {
+	if len(due) == 0 {
 		return nil
 	}
 
+	// The next checks are scheduled before fetching the metrics, so that a
+	// failure is retried after the check interval rather than immediately.
+	now := time.Now()
+	for _, pvca := range due {
+		r.schedule.set(client.ObjectKeyFromObject(pvca), now.Add(r.checkInterval(pvca)))
+	}
+
 	metricsData, err := r.metricsSource.Get(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get metrics: %w", err)
 	}
+	r.metricsData = metricsData
+
+	pvcaToPVCsMap := r.fetchPVCsForPVCAs(ctx, logger, due)
+	r.fetchPrecedingPVCs(ctx, slices.Concat(autoscalers, others), due, pvcaToPVCsMap)
+	r.overlappingPVCs = resolvePVCOwnership(logger, pvcaToPVCsMap)
+	for _, pvca := range autoscalers {
+		if pvcs, ok := pvcaToPVCsMap[pvca]; ok || slices.Contains(due, pvca) {
+			r.pvcIndex.update(client.ObjectKeyFromObject(pvca), pvcs)
+		}
+	}
 
-	pvcaToPVCsMap, pvcToOwnersMap := r.fetchPVCsForPVCAs(ctx, logger, pvcaList.Items)
+	// Quotas and budgets are shared between PVCAs, so the most urgent PVCs
+	// of all PVCAs are reconciled first. The sizes of uniformly scaled PVCs
+	// are aligned afterwards, starting with the most urgent PVCA.
+	r.budgets.reset()
+	if err := r.resizeRequests.reset(ctx); err != nil {
+		logger.Error(err, "failed to list persistentvolumeclaimresizerequests")
+	}
+	duePVCAToPVCsMap := make(map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim, len(due))
+	for _, pvca := range due {
+		if pvcs, ok := pvcaToPVCsMap[pvca]; ok {
+			duePVCAToPVCsMap[pvca] = pvcs
+		}
+	}
+	pvcas := sortPVCAsByUrgency(duePVCAToPVCsMap, metricsData)
 
-	for pvca, pvcs := range pvcaToPVCsMap {
-		r.reconcilePVCA(ctx, logger, pvca, pvcs, pvcToOwnersMap, metricsData)
+	reconciliations := make([]*pvcaReconciliation, 0, len(pvcas))
+	for _, pvca := range pvcas {
+		reconciliations = append(reconciliations, r.beginPVCAReconciliation(ctx, logger, pvca, pvcaToPVCsMap[pvca]))
+	}
+	for _, item := range sortPVCsByUrgency(reconciliations, metricsData) {
+		r.reconcilePVC(ctx, item.rec, item.pvc, metricsData, true)
 	}
+	for _, rec := range reconciliations {
+		r.finishPVCAReconciliation(ctx, rec, true)
+	}
+	r.reportUnmanagedResizeRequests(ctx, logger)
 
 	return nil
 }
 
-// fetchPVCsForPVCAs iterates over all [v1alpha1.PersistentVolumeClaimAutoscaler] items and retrieves all [corev1.PersistentVolumeClaim]
-// that should be scaled by them. It returns a map of [v1alpha1.PersistentVolumeClaimAutoscaler] to [corev1.PersistentVolumeClaim] objects,
-// and a "reverse" map of [corev1.PersistentVolumeClaim] object keys to the list of [v1alpha1.PersistentVolumeClaimAutoscaler]
-// object keys that manage them, which is used to detect [corev1.PersistentVolumeClaim] claimed by more than one [v1alpha1.PersistentVolumeClaimAutoscaler].
-func (r *Runner) fetchPVCsForPVCAs(ctx context.Context, logger logr.Logger, persistentVolumeClaimAutoscalers []v1alpha1.PersistentVolumeClaimAutoscaler) (
-	map[*v1alpha1.PersistentVolumeClaimAutoscaler][]*corev1.PersistentVolumeClaim,
-	map[string][]string,
-) {
+// forgetAutoscalers removes the autoscalers, which are not among the given
+// ones, from the schedule and the index. These autoscalers have been deleted
+// or are no longer reconciled by this [Runner].
+func (r *Runner) forgetAutoscalers(autoscalers []v1alpha1.Autoscaler) {
+	keys := make(map[client.ObjectKey]struct{}, len(autoscalers))
+	for _, pvca := range autoscalers {
+		keys[client.ObjectKeyFromObject(pvca)] = struct{}{}
+	}
+
+	for _, key := range r.schedule.keys() {
+		if _, ok := keys[key]; !ok {
+			r.forgetAutoscaler(key)
+		}
+	}
+}
+
+// forgetAutoscaler removes the autoscaler with the given key from the
+// schedule and the index, and deletes its cost metrics.
+func (r *Runner) forgetAutoscaler(key client.ObjectKey) {
+	r.schedule.remove(key)
+	r.pvcIndex.update(key, nil)
+	r.updateReportedCosts(key, nil)
+}
+
+// fetchPrecedingPVCs adds the PVCs of the autoscalers, which are not
+// reconciled but take precedence over one of the reconciled autoscalers, to
+// the given map. Only these autoscalers can take away PVCs from the reconciled
+// ones. Their status is left to their own reconciliation, which might be done
+// by another instance with a different autoscaler name.
+func (r *Runner) fetchPrecedingPVCs(ctx context.Context, autoscalers, reconciled []v1alpha1.Autoscaler, pvcaToPVCsMap map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim) {
+	for _, other := range autoscalers {
+		if slices.Contains(reconciled, other) {
+			continue
+		}
+
+		precedes := slices.ContainsFunc(reconciled, func(pvca v1alpha1.Autoscaler) bool {
+			return compareAutoscalerPrecedence(other, pvca) < 0
+		})
+		if !precedes {
+			continue
+		}
+
+		if pvcs, err := r.fetchPVCs(ctx, other); err == nil {
+			pvcaToPVCsMap[other] = pvcs
+		}
+	}
+}
+
+// listAutoscalers returns all [v1alpha1.PersistentVolumeClaimAutoscaler] and
+// [v1alpha1.ClusterPersistentVolumeClaimAutoscaler] resources, which are
+// reconciled by this [Runner].
+func (r *Runner) listAutoscalers(ctx context.Context) ([]v1alpha1.Autoscaler, error) {
+	return r.listAutoscalersMatching(ctx, client.MatchingFields{v1alpha1.AutoscalerNameIndexKey: r.autoscalerName})
+}
+
+// listOtherAutoscalers returns all [v1alpha1.PersistentVolumeClaimAutoscaler]
+// and [v1alpha1.ClusterPersistentVolumeClaimAutoscaler] resources, which are
+// reconciled by other instances with a different autoscaler name. A PVC is
+// managed by exactly one autoscaler across all instances, so the precedence
+// is resolved among the autoscalers of all instances.
+func (r *Runner) listOtherAutoscalers(ctx context.Context) ([]v1alpha1.Autoscaler, error) {
+	autoscalers, err := r.listAutoscalersMatching(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(autoscalers, func(pvca v1alpha1.Autoscaler) bool {
+		return pvca.GetAutoscalerName() == r.autoscalerName
+	}), nil
+}
+
+// listAutoscalersMatching returns all [v1alpha1.PersistentVolumeClaimAutoscaler]
+// and [v1alpha1.ClusterPersistentVolumeClaimAutoscaler] resources, which match
+// the given list options.
+func (r *Runner) listAutoscalersMatching(ctx context.Context, opts ...client.ListOption) ([]v1alpha1.Autoscaler, error) {
 	var (
-		pvcaToPVCsMap  = make(map[*v1alpha1.PersistentVolumeClaimAutoscaler][]*corev1.PersistentVolumeClaim, len(persistentVolumeClaimAutoscalers))
-		pvcToOwnersMap = map[string][]string{}
+		pvcaList  v1alpha1.PersistentVolumeClaimAutoscalerList
+		cpvcaList v1alpha1.ClusterPersistentVolumeClaimAutoscalerList
 	)
 
-	for _, pvca := range persistentVolumeClaimAutoscalers {
-		pvcaKey := client.ObjectKeyFromObject(&pvca)
-		logger.V(2).Info("fetching persistentvolumeclaims for persistentvolumeclaimautoscaler", "autoscalerName", r.autoscalerName, "pvca", pvcaKey)
+	if err := r.client.List(ctx, &pvcaList, opts...); err != nil {
+		return nil, err
+	}
+
+	if err := r.client.List(ctx, &cpvcaList, opts...); err != nil {
+		return nil, err
+	}
+
+	autoscalers := make([]v1alpha1.Autoscaler, 0, len(pvcaList.Items)+len(cpvcaList.Items))
+	for i := range pvcaList.Items {
+		autoscalers = append(autoscalers, &pvcaList.Items[i])
+	}
+	for i := range cpvcaList.Items {
+		autoscalers = append(autoscalers, &cpvcaList.Items[i])
+	}
+
+	return autoscalers, nil
+}
+
+// fetchPVCsForPVCAs iterates over all [v1alpha1.Autoscaler] items and retrieves all [corev1.PersistentVolumeClaim]
+// that are selected by them. It returns a map of [v1alpha1.Autoscaler] to [corev1.PersistentVolumeClaim] objects.
+// A [corev1.PersistentVolumeClaim] may be selected by more than one autoscaler, see [resolvePVCOwnership].
+func (r *Runner) fetchPVCsForPVCAs(ctx context.Context, logger logr.Logger, autoscalers []v1alpha1.Autoscaler) map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim {
+	pvcaToPVCsMap := make(map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim, len(autoscalers))
+	r.pvcTargets = make(map[v1alpha1.Autoscaler]map[client.ObjectKey]autoscalingv1.CrossVersionObjectReference, len(autoscalers))
+	r.missingTargets = make(map[v1alpha1.Autoscaler][]autoscalingv1.CrossVersionObjectReference)
+
+	for _, pvca := range autoscalers {
+		pvcaKey := client.ObjectKeyFromObject(pvca)
+		logger.V(2).Info("fetching persistentvolumeclaims for persistentvolumeclaimautoscaler", "autoscalerName", r.autoscalerName, "pvca", pvcaKey, "kind", autoscalerKind(pvca))
 
-		persistentVolumeClaims, err := r.pvcFetcher.Fetch(ctx, &pvca)
+		persistentVolumeClaims, err := r.fetchPVCs(ctx, pvca)
 		if err != nil {
 			reason := ReasonPVCFetchError
 			message := fmt.Sprintf("Failed to fetch PersistentVolumeClaims for PersistentVolumeClaimAutoscaler: %s", err.Error())
 
-			if errors.Is(err, pvcfetcher.ErrNoPodsFound) || errors.Is(err, pvcfetcher.ErrNoPVCsFound) {
+			if errors.Is(err, pvcfetcher.ErrNoPodsFound) || errors.Is(err, pvcfetcher.ErrNoPVCsFound) || errors.Is(err, pvcfetcher.ErrNoSelectedPVCsFound) || errors.Is(err, pvcfetcher.ErrNoTargetsFound) {
 				logger.V(2).Info("no persistentvolumeclaims found for persistentvolumeclaimautoscaler", "pvca", pvcaKey, "reason", err.Error())
 				reason = ReasonNoPVCsMatched
 				message = fmt.Sprintf("No PersistentVolumeClaims found for PersistentVolumeClaimAutoscaler: %s", err.Error())
@@ -298,7 +563,7 @@ func (r *Runner) fetchPVCsForPVCAs(ctx context.Context, logger logr.Logger, pers
 			}
 
 			resizingCondition := metav1.Condition{Type: string(v1alpha1.ConditionTypeResizing)}
-			if existing := meta.FindStatusCondition(pvca.Status.Conditions, resizingCondition.Type); existing != nil {
+			if existing := meta.FindStatusCondition(pvca.GetAutoscalerStatus().Conditions, resizingCondition.Type); existing != nil {
 				resizingCondition = metav1.Condition{
 					Type:    string(v1alpha1.ConditionTypeResizing),
 					Status:  metav1.ConditionUnknown,
@@ -307,151 +572,509 @@ func (r *Runner) fetchPVCsForPVCAs(ctx context.Context, logger logr.Logger, pers
 				}
 			}
 
-			if err := r.setStatus(ctx, &pvca, recommendationsCondition, resizingCondition, []v1alpha1.VolumeRecommendation{}); err != nil {
+			conditions := []metav1.Condition{resizingCondition, recommendationsCondition, suspendedCondition(pvca)}
+			if err := r.setStatus(ctx, pvca, conditions, []v1alpha1.VolumeRecommendation{}, statusSummary{}); err != nil {
 				logger.Error(err, "failed to update PVCA status", "pvca", pvcaKey)
 			}
 
 			continue
 		}
 
-		pvcaToPVCsMap[&pvca] = persistentVolumeClaims
+		pvcaToPVCsMap[pvca] = persistentVolumeClaims
+	}
+
+	return pvcaToPVCsMap
+}
+
+// fetchPVCs returns the [corev1.PersistentVolumeClaim] objects selected by
+// the given [v1alpha1.Autoscaler]. For a
+// [v1alpha1.PersistentVolumeClaimAutoscaler], the target managing each PVC is
+// recorded, so that it can be reported in the volume recommendation, as well
+// as the targets which do not exist.
+func (r *Runner) fetchPVCs(ctx context.Context, pvca v1alpha1.Autoscaler) ([]*corev1.PersistentVolumeClaim, error) {
+	switch obj := pvca.(type) {
+	case *v1alpha1.PersistentVolumeClaimAutoscaler:
+		targets, err := r.pvcFetcher.FetchTargets(ctx, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		// Multiple targets might manage the same PVC, in which case it is
+		// attributed to the first one.
+		pvcTargets := make(map[client.ObjectKey]autoscalingv1.CrossVersionObjectReference)
+		pvcs := make([]*corev1.PersistentVolumeClaim, 0)
+		var missingTargets []autoscalingv1.CrossVersionObjectReference
+		for _, target := range targets {
+			if target.NotFound {
+				missingTargets = append(missingTargets, target.Ref)
+
+				continue
+			}
+
+			for _, pvc := range target.PVCs {
+				key := client.ObjectKeyFromObject(pvc)
+				if _, ok := pvcTargets[key]; ok {
+					continue
+				}
+
+				pvcTargets[key] = target.Ref
+				pvcs = append(pvcs, pvc)
+			}
+		}
+		r.pvcTargets[pvca] = pvcTargets
+		r.missingTargets[pvca] = missingTargets
 
-		for _, pvc := range persistentVolumeClaims {
-			key := client.ObjectKeyFromObject(pvc).String()
-			pvcToOwnersMap[key] = append(pvcToOwnersMap[key], pvcaKey.String())
+		return pvcs, nil
+	case *v1alpha1.ClusterPersistentVolumeClaimAutoscaler:
+		return r.pvcFetcher.FetchForCluster(ctx, obj)
+	default:
+		return nil, fmt.Errorf("unsupported autoscaler type %T", pvca)
+	}
+}
+
+// resolvePVCOwnership makes sure that every [corev1.PersistentVolumeClaim] is
+// managed by exactly one [v1alpha1.Autoscaler], by removing it from all other
+// autoscalers which select it. See [compareAutoscalerPrecedence] for the
+// order in which autoscalers take precedence. It returns the removed PVCs of
+// each autoscaler together with the autoscaler managing them.
+func resolvePVCOwnership(logger logr.Logger, pvcaToPVCsMap map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim) map[v1alpha1.Autoscaler][]string {
+	owners := make(map[client.ObjectKey]v1alpha1.Autoscaler)
+	for pvca, pvcs := range pvcaToPVCsMap {
+		for _, pvc := range pvcs {
+			key := client.ObjectKeyFromObject(pvc)
+			if owner, ok := owners[key]; !ok || compareAutoscalerPrecedence(pvca, owner) < 0 {
+				owners[key] = pvca
+			}
 		}
 	}
 
-	return pvcaToPVCsMap, pvcToOwnersMap
+	overlapping := make(map[v1alpha1.Autoscaler][]string)
+	for pvca, pvcs := range pvcaToPVCsMap {
+		pvcaToPVCsMap[pvca] = slices.DeleteFunc(pvcs, func(pvc *corev1.PersistentVolumeClaim) bool {
+			owner := owners[client.ObjectKeyFromObject(pvc)]
+			if owner == pvca {
+				return false
+			}
+
+			logger.V(1).Info("skipping persistentvolumeclaim, because it is managed by another autoscaler", "pvca", client.ObjectKeyFromObject(pvca), "kind", autoscalerKind(pvca), "pvc", client.ObjectKeyFromObject(pvc), "source", autoscalerSource(owner))
+
+			name := pvc.Name
+			if pvca.GetNamespace() == "" {
+				name = client.ObjectKeyFromObject(pvc).String()
+			}
+			overlapping[pvca] = append(overlapping[pvca], fmt.Sprintf("%s (%s)", name, autoscalerSource(owner)))
+
+			return true
+		})
+	}
+
+	return overlapping
+}
+
+// compareAutoscalerPrecedence compares two [v1alpha1.Autoscaler] objects
+// selecting the same [corev1.PersistentVolumeClaim]. It returns a negative
+// number when a takes precedence over b. A
+// [v1alpha1.PersistentVolumeClaimAutoscaler] takes precedence over a
+// [v1alpha1.ClusterPersistentVolumeClaimAutoscaler]. Among autoscalers of the
+// same kind, the oldest one takes precedence, with ties broken by namespace and
+// name.
+func compareAutoscalerPrecedence(a, b v1alpha1.Autoscaler) int {
+	isCluster := func(pvca v1alpha1.Autoscaler) int {
+		if _, ok := pvca.(*v1alpha1.ClusterPersistentVolumeClaimAutoscaler); ok {
+			return 1
+		}
+
+		return 0
+	}
+
+	return cmp.Or(
+		cmp.Compare(isCluster(a), isCluster(b)),
+		a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time),
+		cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+		cmp.Compare(a.GetName(), b.GetName()),
+	)
+}
+
+// autoscalerKind returns the kind of the given [v1alpha1.Autoscaler].
+func autoscalerKind(pvca v1alpha1.Autoscaler) string {
+	if _, ok := pvca.(*v1alpha1.ClusterPersistentVolumeClaimAutoscaler); ok {
+		return "ClusterPersistentVolumeClaimAutoscaler"
+	}
+
+	return "PersistentVolumeClaimAutoscaler"
+}
+
+// autoscalerSource returns the source reported in the
+// [v1alpha1.VolumeRecommendation] items of the given [v1alpha1.Autoscaler].
+func autoscalerSource(pvca v1alpha1.Autoscaler) string {
+	return autoscalerKind(pvca) + "/" + pvca.GetName()
+}
+
+// uniformScalingMember is a [corev1.PersistentVolumeClaim] which belongs to a
+// group of PVCs matched by a volume policy with uniform scaling enabled.
+type uniformScalingMember struct {
+	pvc         *corev1.PersistentVolumeClaim
+	inProgress  bool
+	paused      bool
+	maxCapacity resource.Quantity
+}
+
+// uniformScalingGroupKey identifies a group of PVCs, which are scaled
+// uniformly. A volume policy may match the PVCs of unrelated workloads, e.g.
+// of multiple targets or, for a
+// [v1alpha1.ClusterPersistentVolumeClaimAutoscaler], of multiple namespaces,
+// so PVCs are only aligned within their target and namespace. The target is
+// empty for a [v1alpha1.ClusterPersistentVolumeClaimAutoscaler].
+type uniformScalingGroupKey struct {
+	policyIndex int
+	namespace   string
+	target      autoscalingv1.CrossVersionObjectReference
+}
+
+// uniformScalingGroups maps the groups of PVCs, which are scaled uniformly, to
+// their members.
+type uniformScalingGroups map[uniformScalingGroupKey][]uniformScalingMember
+
+// add adds the member, which is managed by the given target, to the group of
+// the volume policy with the given index.
+func (g uniformScalingGroups) add(policyIndex int, target autoscalingv1.CrossVersionObjectReference, member uniformScalingMember) {
+	key := uniformScalingGroupKey{policyIndex: policyIndex, namespace: member.pvc.Namespace, target: target}
+	g[key] = append(g[key], member)
+}
+
+// keys returns the keys of the groups ordered by volume policy, namespace and
+// target.
+func (g uniformScalingGroups) keys() []uniformScalingGroupKey {
+	return slices.SortedFunc(maps.Keys(g), func(a, b uniformScalingGroupKey) int {
+		return cmp.Or(
+			cmp.Compare(a.policyIndex, b.policyIndex),
+			cmp.Compare(a.namespace, b.namespace),
+			cmp.Compare(a.target.APIVersion, b.target.APIVersion),
+			cmp.Compare(a.target.Kind, b.target.Kind),
+			cmp.Compare(a.target.Name, b.target.Name),
+		)
+	})
+}
+
+// pvcaReconciliation is the state of the reconciliation of a single
+// [v1alpha1.Autoscaler]. It allows to reconcile the PVCs of multiple
+// autoscalers in a common order, before the status of each autoscaler is
+// updated.
+type pvcaReconciliation struct {
+	logger logr.Logger
+	pvca   v1alpha1.Autoscaler
+	pvcs   []*corev1.PersistentVolumeClaim
+
+	volumePolicies       []v1alpha1.VolumePolicy
+	volumeClaimTemplates map[string]string
+
+	resizingConditions       *resizingConditionAggregator
+	recommendationConditions *recommendationsConditionAggregator
+
+	uniformScalingGroups  uniformScalingGroups
+	prices                map[client.ObjectKey]*resource.Quantity
+	pausedPVCs            []string
+	volumeRecommendations []v1alpha1.VolumeRecommendation
 }
 
 // reconcilePVCA reconciles one [v1alpha1.PersistentVolumeClaimAutoscaler]
-// and resizes [corev1.PersistentVolumeClaim] managed by it when thresholds are reached.
+// and resizes [corev1.PersistentVolumeClaim] managed by it when thresholds are
+// reached. Thresholds are only evaluated with fresh metrics, since metrics
+// which have been fetched before do not reflect the resizes performed since.
 func (r *Runner) reconcilePVCA(
 	ctx context.Context,
 	logger logr.Logger,
-	pvca *v1alpha1.PersistentVolumeClaimAutoscaler,
+	pvca v1alpha1.Autoscaler,
 	pvcs []*corev1.PersistentVolumeClaim,
-	pvcToOwnersMap map[string][]string,
 	metricsData metricssource.Metrics,
+	freshMetrics bool,
 ) {
-	logger = logger.WithValues("pvca", client.ObjectKeyFromObject(pvca))
+	rec := r.beginPVCAReconciliation(ctx, logger, pvca, pvcs)
+	for _, pvc := range pvcs {
+		r.reconcilePVC(ctx, rec, pvc, metricsData, freshMetrics)
+	}
+	r.finishPVCAReconciliation(ctx, rec, freshMetrics)
+}
 
-	resizingConditions := &resizingConditionAggregator{}
-	recommendationConditions := &recommendationsConditionAggregator{}
+// beginPVCAReconciliation starts the reconciliation of the given
+// [v1alpha1.Autoscaler] with the given PVCs, which are reconciled one by one
+// with [Runner.reconcilePVC] afterwards.
+func (r *Runner) beginPVCAReconciliation(
+	ctx context.Context,
+	logger logr.Logger,
+	pvca v1alpha1.Autoscaler,
+	pvcs []*corev1.PersistentVolumeClaim,
+) *pvcaReconciliation {
+	rec := &pvcaReconciliation{
+		logger: logger.WithValues("pvca", client.ObjectKeyFromObject(pvca), "kind", autoscalerKind(pvca)),
+		pvca:   pvca,
+		pvcs:   pvcs,
+		// Evaluate defaulted copies of the volume policies, so that objects which
+		// have not been defaulted by the API server cannot break the evaluation.
+		volumePolicies:           v1alpha1.DefaultedVolumePolicies(pvca.GetVolumePolicies()),
+		resizingConditions:       &resizingConditionAggregator{},
+		recommendationConditions: &recommendationsConditionAggregator{},
+		uniformScalingGroups:     make(uniformScalingGroups),
+		prices:                   make(map[client.ObjectKey]*resource.Quantity, len(pvcs)),
+		pausedPVCs:               make([]string, 0),
+		volumeRecommendations:    make([]v1alpha1.VolumeRecommendation, 0, len(pvcs)),
+	}
 
-	volumeRecommendations := make([]v1alpha1.VolumeRecommendation, 0, len(pvcs))
-	for _, volumeRecommendation := range pvca.Status.VolumeRecommendations {
+	for _, volumeRecommendation := range pvca.GetAutoscalerStatus().VolumeRecommendations {
 		if slices.ContainsFunc(pvcs, func(pvc *corev1.PersistentVolumeClaim) bool {
-			return pvc.Name == volumeRecommendation.Name
+			return isVolumeRecommendationForPVC(volumeRecommendation, pvc) && !isVolumeRecommendationOutdated(volumeRecommendation, pvc)
 		}) {
-			volumeRecommendations = append(volumeRecommendations, volumeRecommendation)
+			rec.volumeRecommendations = append(rec.volumeRecommendations, volumeRecommendation)
 		}
 	}
 
-	for _, pvc := range pvcs {
-		pvcObjKey := client.ObjectKeyFromObject(pvc)
-		logger := logger.WithValues("pvc", pvcObjKey)
+	rec.volumeClaimTemplates = r.fetchVolumeClaimTemplates(ctx, rec.logger, pvca, pvcs, rec.recommendationConditions)
 
-		if owners, ok := pvcToOwnersMap[pvcObjKey.String()]; ok && len(owners) > 1 {
-			logger.Info("skipping persistentvolumeclaim because it is scaled by multiple persistentvolumeclaimautoscalers", "pvcas", strings.Join(owners, ", "))
-			recommendationConditions.addCondition(metav1.Condition{
-				Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
-				Status:  metav1.ConditionFalse,
-				Reason:  ReasonAmbiguousPVCA,
-				Message: fmt.Sprintf("PersistentVolumeClaim %s is scaled by multiple PersistentVolumeClaimAutoscalers: %s", pvcObjKey, strings.Join(owners, ", ")),
-			})
+	for _, targetRef := range r.missingTargets[pvca] {
+		rec.logger.Info("skipping target", "reason", "target not found", "target", targetRef.Kind+"/"+targetRef.Name)
+		rec.recommendationConditions.addCondition(metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonTargetNotFound,
+			Message: fmt.Sprintf("Target %s %s not found", targetRef.Kind, targetRef.Name),
+		})
+	}
 
-			continue
-		}
+	return rec
+}
 
-		// Get a fresh copy of the pvc object.
-		if err := r.client.Get(ctx, pvcObjKey, pvc); err != nil {
-			logger.Info("failed to get persistentvolumeclaim", "reason", err.Error())
-			recommendationConditions.addCondition(metav1.Condition{
-				Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
-				Status:  metav1.ConditionFalse,
-				Reason:  ReasonPVCFetchError,
-				Message: fmt.Sprintf("Failed to get PersistentVolumeClaim %s: %s", pvcObjKey, err.Error()),
-			})
+// reconcilePVC provides the recommendation for a single
+// [corev1.PersistentVolumeClaim] of the autoscaler and resizes it, when its
+// thresholds are reached.
+func (r *Runner) reconcilePVC(
+	ctx context.Context,
+	rec *pvcaReconciliation,
+	pvc *corev1.PersistentVolumeClaim,
+	metricsData metricssource.Metrics,
+	freshMetrics bool,
+) {
+	pvca := rec.pvca
+	pvcObjKey := client.ObjectKeyFromObject(pvc)
+	logger := rec.logger.WithValues("pvc", pvcObjKey)
+
+	// Get a fresh copy of the pvc object.
+	if err := r.client.Get(ctx, pvcObjKey, pvc); err != nil {
+		logger.Info("failed to get persistentvolumeclaim", "reason", err.Error())
+		rec.recommendationConditions.addCondition(metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonPVCFetchError,
+			Message: fmt.Sprintf("Failed to get PersistentVolumeClaim %s: %s", pvcObjKey, err.Error()),
+		})
 
-			continue
-		}
+		return
+	}
 
-		policy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
-		if err != nil {
-			logger.Info("skipping persistentvolumeclaim", "reason", err.Error())
-			recommendationConditions.addCondition(metav1.Condition{
-				Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
-				Status:  metav1.ConditionFalse,
-				Reason:  ReasonRecommendationError,
-				Message: fmt.Sprintf("%s: %s", pvcObjKey.Name, err.Error()),
-			})
+	policy, policyIndex, err := getVolumePolicy(pvc, rec.volumeClaimTemplates[pvc.Name], rec.volumePolicies)
+	if err != nil {
+		logger.Info("skipping persistentvolumeclaim", "reason", err.Error())
+		rec.recommendationConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonRecommendationError,
+			Message: err.Error(),
+		})
 
-			continue
-		}
+		return
+	}
 
-		if policy == nil {
-			logger.Info("skipping persistentvolumeclaim", "reason", "no matching volume policy")
-			recommendationConditions.addCondition(metav1.Condition{
-				Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
-				Status:  metav1.ConditionFalse,
-				Reason:  ReasonRecommendationError,
-				Message: fmt.Sprintf("%s: no matching volume policy", pvcObjKey.Name),
-			})
+	if policy == nil {
+		logger.Info("skipping persistentvolumeclaim", "reason", "no matching volume policy")
+		rec.recommendationConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonRecommendationError,
+			Message: "no matching volume policy",
+		})
 
-			continue
-		}
+		return
+	}
 
-		if err := r.validatePVC(ctx, pvc, *policy); err != nil {
-			logger.Info("skipping persistentvolumeclaim", "reason", err.Error())
-			recommendationConditions.addCondition(metav1.Condition{
-				Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
-				Status:  metav1.ConditionFalse,
-				Reason:  ReasonRecommendationError,
-				Message: fmt.Sprintf("%s: %s", pvcObjKey.Name, err.Error()),
-			})
+	if err := r.validatePVC(ctx, pvc, *policy); err != nil {
+		logger.Info("skipping persistentvolumeclaim", "reason", err.Error())
+		rec.recommendationConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonRecommendationError,
+			Message: err.Error(),
+		})
 
-			continue
+		return
+	}
+
+	price, err := r.storagePrice(ctx, pvc)
+	if err != nil {
+		logger.Info("failed to determine storage price", "reason", err.Error())
+	}
+	rec.prices[pvcObjKey] = price
+
+	// The max monthly cost limits the resize just like the max capacity
+	resizePolicy := *policy
+	var costLimited bool
+	resizePolicy.MaxCapacity, costLimited = maxCapacityWithinCost(*policy, price, *pvc.Spec.Resources.Requests.Storage())
+
+	// Suspended PVCAs and paused PVCs are evaluated like the Off resize
+	// strategy, which provides recommendations without patching the PVC.
+	paused := isPVCPaused(pvc)
+	if paused {
+		rec.pausedPVCs = append(rec.pausedPVCs, pvc.Name)
+	}
+	if paused || pvca.IsSuspended() {
+		scaleUp := *resizePolicy.ScaleUp
+		scaleUp.ResizeStrategy = v1alpha1.OffVolumeResizeStrategy
+		resizePolicy.ScaleUp = &scaleUp
+	}
+
+	volumeRecommendation, err := r.updateVolumeRecommendationForPVC(rec.volumeRecommendations, pvc, metricsData[pvcObjKey])
+	if err != nil {
+		logger.Info("skipping persistentvolumeclaim", "reason", err.Error())
+		metrics.SkippedTotal.WithLabelValues(pvca.GetNamespace(), pvca.GetName(), err.Error()).Inc()
+		rec.recommendationConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonMetricsFetchError,
+			Message: err.Error(),
+		})
+
+		return
+	}
+
+	volumeRecommendation.VolumePolicyIndex = ptr.To(policyIndex)
+	volumeRecommendation.UID = pvc.UID
+	volumeRecommendation.Source = autoscalerSource(pvca)
+	volumeRecommendation.TargetRef = nil
+	if targetRef, ok := r.pvcTargets[pvca][client.ObjectKeyFromObject(pvc)]; ok {
+		volumeRecommendation.TargetRef = &targetRef
+	}
+	if pvca.GetNamespace() == "" {
+		volumeRecommendation.Namespace = pvc.Namespace
+	}
+	rec.recommendationConditions.addPVCCondition(pvc, metav1.Condition{
+		Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonMetricsFetched,
+		Message: "Recommendation has been provided",
+	})
+
+	var (
+		shouldResize  bool
+		scalingReason string
+	)
+	if freshMetrics {
+		shouldResize, scalingReason = r.shouldResizePVC(pvc, *policy, volumeRecommendation)
+		recordThresholdBreach(&volumeRecommendation, shouldResize)
+	}
+	inProgressReason := scalingReason
+	if inProgressReason == "" {
+		inProgressReason = latestScalingReason(volumeRecommendation)
+	}
+	inProgress := r.isResizeInProgress(logger, pvc, inProgressReason, rec.resizingConditions)
+	if !inProgress {
+		completeResize(pvc, &volumeRecommendation)
+	}
+
+	// Resize requests are executed before, and instead of, automatic resizes
+	if request := r.resizeRequests.next(pvc); request != nil {
+		if r.reconcileResizeRequest(ctx, logger, request, pvc, resizePolicy, paused || pvca.IsSuspended(), inProgress, &volumeRecommendation, rec.resizingConditions) {
+			inProgress = true
 		}
+	}
 
-		volumeRecommendation, err := r.updateVolumeRecommendationForPVC(volumeRecommendations, pvc, metricsData[pvcObjKey])
+	if shouldResize && !inProgress && r.isStabilizationWindowElapsed(logger, pvc, *policy, volumeRecommendation, rec.resizingConditions) {
+		volumeRecommendation, err = r.resizePVC(ctx, logger, pvca, pvc, resizePolicy, costLimited, scalingReason, volumeRecommendation, rec.resizingConditions)
 		if err != nil {
-			logger.Info("skipping persistentvolumeclaim", "reason", err.Error())
-			metrics.SkippedTotal.WithLabelValues(pvca.Namespace, pvca.Name, err.Error()).Inc()
-			recommendationConditions.addCondition(metav1.Condition{
-				Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
-				Status:  metav1.ConditionFalse,
-				Reason:  ReasonMetricsFetchError,
-				Message: fmt.Sprintf("%s: %s", pvcObjKey.Name, err.Error()),
-			})
-
-			continue
+			logger.Error(err, "failed to resize pvc")
 		}
+	}
 
-		shouldResize, scalingReason := r.shouldResizePVC(pvc, *policy, volumeRecommendation)
-		inProgress := r.isResizeInProgress(logger, pvc, scalingReason, resizingConditions)
+	setVolumeRecommendationForPVC(&rec.volumeRecommendations, pvc, volumeRecommendation)
 
-		if shouldResize && !inProgress {
-			volumeRecommendation, err = r.resizePVC(ctx, logger, pvc, *policy, scalingReason, volumeRecommendation, resizingConditions)
-			if err != nil {
-				logger.Error(err, "failed to resize pvc")
-			}
+	if policy.UniformScaling {
+		rec.uniformScalingGroups.add(policyIndex, r.pvcTargets[pvca][pvcObjKey], uniformScalingMember{pvc: pvc, inProgress: inProgress, paused: paused || pvca.IsSuspended(), maxCapacity: resizePolicy.MaxCapacity})
+	}
+}
+
+// finishPVCAReconciliation aligns the sizes of the uniformly scaled PVCs of
+// the autoscaler and updates its status.
+func (r *Runner) finishPVCAReconciliation(ctx context.Context, rec *pvcaReconciliation, freshMetrics bool) {
+	// Aligning the sizes resizes PVCs as well, so it is only done by the
+	// scheduled checks, like the resizes based on the utilization.
+	if freshMetrics {
+		for _, key := range rec.uniformScalingGroups.keys() {
+			r.scaleUniformly(ctx, rec.logger, rec.pvca, rec.volumePolicies[key.policyIndex], key.policyIndex, rec.uniformScalingGroups[key], &rec.volumeRecommendations, rec.resizingConditions)
 		}
+	}
+
+	r.updateReportedCosts(client.ObjectKeyFromObject(rec.pvca), setMonthlyCosts(rec.pvca, rec.volumeRecommendations, rec.prices))
+	setPVCConditions(rec.pvca, rec.pvcs, &rec.volumeRecommendations, rec.recommendationConditions, rec.resizingConditions)
 
-		setVolumeRecommendationForPVC(&volumeRecommendations, pvc.Name, volumeRecommendation)
+	conditions := []metav1.Condition{
+		rec.resizingConditions.getAggregatedCondition(),
+		rec.recommendationConditions.getAggregatedCondition(),
+		suspendedCondition(rec.pvca),
+		pausedCondition(rec.pausedPVCs),
+		overlappingCondition(r.overlappingPVCs[rec.pvca]),
 	}
+	// Only the scheduled checks update the time of the last reconciliation,
+	// so that an event-driven reconciliation, which does not change anything
+	// else, does not patch the status.
+	var reconcileTime *metav1.Time
+	if freshMetrics {
+		reconcileTime = ptr.To(metav1.Now())
+	}
+	summary := summarize(reconcileTime, rec.pvcs, rec.volumeRecommendations, rec.recommendationConditions, rec.resizingConditions)
+	if err := r.setStatus(ctx, rec.pvca, conditions, rec.volumeRecommendations, summary); err != nil {
+		rec.logger.Error(err, "failed to update PVCA status")
+	}
+}
 
-	if err := r.setStatus(ctx, pvca, recommendationConditions.getAggregatedCondition(), resizingConditions.getAggregatedCondition(), volumeRecommendations); err != nil {
-		logger.Error(err, "failed to update PVCA status")
+// fetchVolumeClaimTemplates returns a map of [corev1.PersistentVolumeClaim]
+// names to the StatefulSet volumeClaimTemplate they have been created from.
+// The StatefulSet is only looked up when one of the volume policies matches by
+// volumeClaimTemplate.
+func (r *Runner) fetchVolumeClaimTemplates(
+	ctx context.Context,
+	logger logr.Logger,
+	autoscaler v1alpha1.Autoscaler,
+	pvcs []*corev1.PersistentVolumeClaim,
+	recommendationConditions *recommendationsConditionAggregator,
+) map[string]string {
+	// Only the targetRef of a PersistentVolumeClaimAutoscaler can be a StatefulSet
+	pvca, ok := autoscaler.(*v1alpha1.PersistentVolumeClaimAutoscaler)
+	if !ok {
+		return nil
+	}
+
+	if !slices.ContainsFunc(pvca.Spec.VolumePolicies, func(policy v1alpha1.VolumePolicy) bool {
+		return policy.Match.VolumeClaimTemplate != ""
+	}) {
+		return nil
 	}
+
+	volumeClaimTemplates, err := r.pvcFetcher.FetchVolumeClaimTemplates(ctx, pvca, pvcs)
+	if err != nil {
+		logger.Info("failed to fetch volume claim templates", "reason", err.Error())
+		recommendationConditions.addCondition(metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonPVCFetchError,
+			Message: fmt.Sprintf("Failed to fetch volume claim templates: %s", err.Error()),
+		})
+	}
+
+	return volumeClaimTemplates
 }
 
 // updateVolumeRecommendations updates the status of the
 // [v1alpha1.PersistentVolumeClaimAutoscaler] with the latest observed
 // information about the target [corev1.PersistentVolumeClaim].
 func (r *Runner) updateVolumeRecommendationForPVC(volumeRecommendations []v1alpha1.VolumeRecommendation, pvc *corev1.PersistentVolumeClaim, volInfo *metricssource.VolumeInfo) (v1alpha1.VolumeRecommendation, error) {
-	volumeRecommendation := getOrCreateVolumeRecommendationForPVC(volumeRecommendations, pvc.Name)
+	volumeRecommendation := getOrCreateVolumeRecommendationForPVC(volumeRecommendations, pvc)
 
 	// No metrics found, nothing to do for now
 	if volInfo == nil {
@@ -494,44 +1117,70 @@ func (r *Runner) updateVolumeRecommendationForPVC(volumeRecommendations []v1alph
 	return volumeRecommendation, nil
 }
 
-// getVolumePolicy returns the VolumePolicy for a given [corev1.PersistentVolumeClaim] name.
-// It returns nil if there is no policy specified for the [corev1.PersistentVolumeClaim].
-// Policies are evaluated in the order they appear in the list, and the first
-// matching policy is returned.
-func getVolumePolicy(pvcName string, volumePolicies []v1alpha1.VolumePolicy) (*v1alpha1.VolumePolicy, error) {
-	for _, volumePolicy := range volumePolicies {
-		matched, err := path.Match(volumePolicy.Match.Name, pvcName)
+// getVolumePolicy returns the VolumePolicy for a given
+// [corev1.PersistentVolumeClaim] together with its index within the list of
+// policies. It returns nil and -1 if there is no policy specified for the
+// [corev1.PersistentVolumeClaim]. Policies are evaluated in the order they
+// appear in the list, and the first matching policy is returned. A policy
+// matches when the name of the [corev1.PersistentVolumeClaim] matches its name
+// pattern and, if specified, its labels match the label selector, it uses the
+// StorageClass of the policy and it has been created from the
+// volumeClaimTemplate of the policy. The volumeClaimTemplate is empty for
+// [corev1.PersistentVolumeClaim] objects not created from a StatefulSet
+// volumeClaimTemplate.
+func getVolumePolicy(pvc *corev1.PersistentVolumeClaim, volumeClaimTemplate string, volumePolicies []v1alpha1.VolumePolicy) (*v1alpha1.VolumePolicy, int, error) {
+	for i := range volumePolicies {
+		matched, err := volumePolicies[i].Match.Matches(pvc, volumeClaimTemplate)
 		if err != nil {
-			return nil, fmt.Errorf("invalid volume policy name %q: %w", volumePolicy.Match.Name, err)
+			return nil, -1, err
 		}
 		if matched {
-			return &volumePolicy, nil
+			return &volumePolicies[i], i, nil
 		}
 	}
 
-	return nil, nil
+	return nil, -1, nil
+}
+
+// isVolumeRecommendationForPVC returns whether the [v1alpha1.VolumeRecommendation]
+// belongs to the given [corev1.PersistentVolumeClaim]. The namespace of a
+// recommendation is only set by cluster-scoped autoscalers, whose PVCs may
+// reside in different namespaces.
+func isVolumeRecommendationForPVC(volumeRecommendation v1alpha1.VolumeRecommendation, pvc *corev1.PersistentVolumeClaim) bool {
+	return volumeRecommendation.Name == pvc.Name && (volumeRecommendation.Namespace == "" || volumeRecommendation.Namespace == pvc.Namespace)
+}
+
+// isVolumeRecommendationOutdated returns whether the given
+// [v1alpha1.VolumeRecommendation] has been recorded for an earlier
+// [corev1.PersistentVolumeClaim] with the same name. This happens when the
+// PVC of a generic ephemeral volume is recreated together with its pod.
+// Recommendations without a UID have been recorded before the UID was
+// tracked and are kept.
+func isVolumeRecommendationOutdated(volumeRecommendation v1alpha1.VolumeRecommendation, pvc *corev1.PersistentVolumeClaim) bool {
+	return volumeRecommendation.UID != "" && volumeRecommendation.UID != pvc.UID
 }
 
 // getOrCreateVolumeRecommendationForPVC returns the [v1alpha1.VolumeRecommendation] for
-// the given [corev1.PersistentVolumeClaim] name. If no recommendation exists yet, a new one is created and returned.
-func getOrCreateVolumeRecommendationForPVC(volumeRecommendations []v1alpha1.VolumeRecommendation, pvcName string) v1alpha1.VolumeRecommendation {
+// the given [corev1.PersistentVolumeClaim]. If no recommendation exists yet, a new one is created and returned.
+func getOrCreateVolumeRecommendationForPVC(volumeRecommendations []v1alpha1.VolumeRecommendation, pvc *corev1.PersistentVolumeClaim) v1alpha1.VolumeRecommendation {
 	for i := range volumeRecommendations {
-		if volumeRecommendations[i].Name == pvcName {
+		if isVolumeRecommendationForPVC(volumeRecommendations[i], pvc) {
 			return volumeRecommendations[i]
 		}
 	}
 
 	return v1alpha1.VolumeRecommendation{
-		Name: pvcName,
+		Name: pvc.Name,
+		UID:  pvc.UID,
 	}
 }
 
 // setVolumeRecommendationForPVC sets the [v1alpha1.VolumeRecommendation] for the [corev1.PersistentVolumeClaim] in
 // the [v1alpha1.PersistentVolumeClaimAutoscaler]. If it did not exist before, it is appended to the list of volume
 // recommendations.
-func setVolumeRecommendationForPVC(volumeRecommendations *[]v1alpha1.VolumeRecommendation, pvcName string, volumeRecommendation v1alpha1.VolumeRecommendation) {
+func setVolumeRecommendationForPVC(volumeRecommendations *[]v1alpha1.VolumeRecommendation, pvc *corev1.PersistentVolumeClaim, volumeRecommendation v1alpha1.VolumeRecommendation) {
 	for i := range *volumeRecommendations {
-		if (*volumeRecommendations)[i].Name == pvcName {
+		if isVolumeRecommendationForPVC((*volumeRecommendations)[i], pvc) {
 			(*volumeRecommendations)[i] = volumeRecommendation
 
 			return
@@ -606,7 +1255,7 @@ func (r *Runner) shouldResizePVC(pvc *corev1.PersistentVolumeClaim, policy v1alp
 		)
 		metrics.ThresholdReachedTotal.WithLabelValues(pvc.Namespace, pvc.Name, "space").Inc()
 
-		return true, "passing storage threshold"
+		return true, scalingReasonSpace
 
 	// Used inodes reached threshold
 	case usedInodesPercent > threshold:
@@ -620,7 +1269,7 @@ func (r *Runner) shouldResizePVC(pvc *corev1.PersistentVolumeClaim, policy v1alp
 		)
 		metrics.ThresholdReachedTotal.WithLabelValues(pvc.Namespace, pvc.Name, "inodes").Inc()
 
-		return true, "passing inodes threshold"
+		return true, scalingReasonInodes
 
 	// No need to reconcile the PVC for now
 	default:
@@ -628,18 +1277,130 @@ func (r *Runner) shouldResizePVC(pvc *corev1.PersistentVolumeClaim, policy v1alp
 	}
 }
 
+// recordThresholdBreach records the time since which the utilization
+// threshold has continuously been exceeded in the given
+// [v1alpha1.VolumeRecommendation]. The recorded time is cleared as soon as the
+// threshold is no longer exceeded.
+func recordThresholdBreach(volumeRecommendation *v1alpha1.VolumeRecommendation, thresholdExceeded bool) {
+	if !thresholdExceeded {
+		volumeRecommendation.ThresholdBreachStartTime = nil
+
+		return
+	}
+
+	if volumeRecommendation.ThresholdBreachStartTime == nil {
+		volumeRecommendation.ThresholdBreachStartTime = ptr.To(metav1.Now())
+	}
+}
+
+// isStabilizationWindowElapsed is a predicate which checks whether the
+// utilization threshold has been exceeded for at least the stabilization window
+// of the policy. Policies without a stabilization window, as well as
+// [corev1.PersistentVolumeClaim] objects whose utilization passed the critical
+// threshold, are always considered stable.
+func (r *Runner) isStabilizationWindowElapsed(logger logr.Logger, pvc *corev1.PersistentVolumeClaim, policy v1alpha1.VolumePolicy, volumeRecommendation v1alpha1.VolumeRecommendation, resizingConditions *resizingConditionAggregator) bool {
+	if policy.ScaleUp.StabilizationWindow == nil || volumeRecommendation.ThresholdBreachStartTime == nil {
+		return true
+	}
+
+	if policy.ScaleUp.CriticalUtilizationPercent != nil && isUtilizationAbove(volumeRecommendation, *policy.ScaleUp.CriticalUtilizationPercent) {
+		return true
+	}
+
+	elapsed := time.Since(volumeRecommendation.ThresholdBreachStartTime.Time)
+	window := policy.ScaleUp.StabilizationWindow.Duration
+	if elapsed >= window {
+		return true
+	}
+
+	logger.Info("stabilization window not elapsed", "remaining", (window - elapsed).String())
+	if policy.ScaleUp.ResizeStrategy != v1alpha1.OffVolumeResizeStrategy {
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonPVCResizeStabilization,
+			Message: "utilization threshold has not been exceeded for the whole stabilization window yet",
+		})
+	}
+
+	return false
+}
+
+// isPVCPaused is a predicate which checks whether resizing of the given
+// [corev1.PersistentVolumeClaim] has been paused via the
+// [common.AnnotationPaused] annotation.
+func isPVCPaused(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvc.Annotations[common.AnnotationPaused] == "true"
+}
+
+// isUtilizationAbove is a predicate which checks whether the used space or
+// inodes of the given [v1alpha1.VolumeRecommendation] are above the given
+// percentage.
+func isUtilizationAbove(volumeRecommendation v1alpha1.VolumeRecommendation, percent int) bool {
+	return ptr.Deref(volumeRecommendation.Current.UsedSpacePercent, 0) > percent ||
+		ptr.Deref(volumeRecommendation.Current.UsedInodesPercent, 0) > percent
+}
+
+// isCriticalUtilizationReached is a predicate which checks whether the used
+// space or inodes of the [corev1.PersistentVolumeClaim] passed the critical
+// utilization threshold of the policy. When it returns true, the cooldown
+// duration of the policy must be ignored.
+func (r *Runner) isCriticalUtilizationReached(pvc *corev1.PersistentVolumeClaim, policy v1alpha1.VolumePolicy, volumeRecommendation v1alpha1.VolumeRecommendation) bool {
+	if policy.ScaleUp.CriticalUtilizationPercent == nil {
+		return false
+	}
+
+	var (
+		critical          = *policy.ScaleUp.CriticalUtilizationPercent
+		usedSpacePercent  = ptr.Deref(volumeRecommendation.Current.UsedSpacePercent, 0)
+		usedInodesPercent = ptr.Deref(volumeRecommendation.Current.UsedInodesPercent, 0)
+	)
+
+	switch {
+	case usedSpacePercent > critical:
+		r.eventRecorder.Eventf(
+			pvc,
+			corev1.EventTypeWarning,
+			"UsedSpaceCriticalThresholdReached",
+			"used space (%d%%) exceeds the configured critical threshold (%d%%), bypassing cooldown",
+			usedSpacePercent,
+			critical,
+		)
+		metrics.CriticalThresholdReachedTotal.WithLabelValues(pvc.Namespace, pvc.Name, "space").Inc()
+
+		return true
+
+	case usedInodesPercent > critical:
+		r.eventRecorder.Eventf(
+			pvc,
+			corev1.EventTypeWarning,
+			"UsedInodesCriticalThresholdReached",
+			"used inodes (%d%%) exceeds the configured critical threshold (%d%%), bypassing cooldown",
+			usedInodesPercent,
+			critical,
+		)
+		metrics.CriticalThresholdReachedTotal.WithLabelValues(pvc.Namespace, pvc.Name, "inodes").Inc()
+
+		return true
+
+	default:
+		return false
+	}
+}
+
 // isResizeInProgress checks whether the [corev1.PersistentVolumeClaim] is currently being resized.
-// Returns true if a resize operation is in progress.
+// Returns true if a resize operation is in progress. The scaling reason may be empty, when it is
+// not known.
 func (r *Runner) isResizeInProgress(logger logr.Logger, pvc *corev1.PersistentVolumeClaim, scalingReason string, resizingConditions *resizingConditionAggregator) bool {
 	currStatusSize := pvc.Status.Capacity.Storage()
 
 	if utils.IsPersistentVolumeClaimConditionTrue(pvc, corev1.PersistentVolumeClaimResizing) {
 		logger.Info("resize has been started")
-		resizingConditions.addCondition(metav1.Condition{
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
 			Type:    string(v1alpha1.ConditionTypeResizing),
 			Status:  metav1.ConditionTrue,
 			Reason:  ReasonReconcile,
-			Message: fmt.Sprintf("%s: is being scaled due to %s, resize has been started", pvc.Name, scalingReason),
+			Message: resizingMessage(scalingReason, "resize has been started"),
 		})
 
 		return true
@@ -647,11 +1408,11 @@ func (r *Runner) isResizeInProgress(logger logr.Logger, pvc *corev1.PersistentVo
 
 	if utils.IsPersistentVolumeClaimConditionTrue(pvc, corev1.PersistentVolumeClaimFileSystemResizePending) {
 		logger.Info("filesystem resize is pending")
-		resizingConditions.addCondition(metav1.Condition{
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
 			Type:    string(v1alpha1.ConditionTypeResizing),
 			Status:  metav1.ConditionTrue,
 			Reason:  ReasonReconcile,
-			Message: fmt.Sprintf("%s: is being scaled due to %s, file system resize is pending", pvc.Name, scalingReason),
+			Message: resizingMessage(scalingReason, "file system resize is pending"),
 		})
 
 		return true
@@ -659,11 +1420,11 @@ func (r *Runner) isResizeInProgress(logger logr.Logger, pvc *corev1.PersistentVo
 
 	if utils.IsPersistentVolumeClaimConditionTrue(pvc, corev1.PersistentVolumeClaimVolumeModifyingVolume) {
 		logger.Info("volume is being modified")
-		resizingConditions.addCondition(metav1.Condition{
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
 			Type:    string(v1alpha1.ConditionTypeResizing),
 			Status:  metav1.ConditionTrue,
 			Reason:  ReasonReconcile,
-			Message: fmt.Sprintf("%s: is being scaled due to %s, volume is being modified", pvc.Name, scalingReason),
+			Message: resizingMessage(scalingReason, "volume is being modified"),
 		})
 
 		return true
@@ -677,11 +1438,11 @@ func (r *Runner) isResizeInProgress(logger logr.Logger, pvc *corev1.PersistentVo
 
 	scaledFrom, err := resource.ParseQuantity(scaledFromAnnotationValue)
 	if err != nil {
-		resizingConditions.addCondition(metav1.Condition{
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
 			Type:    string(v1alpha1.ConditionTypeResizing),
 			Status:  metav1.ConditionUnknown,
 			Reason:  ReasonReconcile,
-			Message: fmt.Sprintf("%s: could not parse %s annotation with value %s: %s", pvc.Name, common.AnnotationPreviousSize, scaledFromAnnotationValue, err.Error()),
+			Message: fmt.Sprintf("could not parse %s annotation with value %s: %s", common.AnnotationPreviousSize, scaledFromAnnotationValue, err.Error()),
 		})
 
 		return true
@@ -692,11 +1453,11 @@ func (r *Runner) isResizeInProgress(logger logr.Logger, pvc *corev1.PersistentVo
 	// to do the resizing might have started it, but not yet updated the PVC's conditions.
 	if scaledFrom.Equal(*currStatusSize) {
 		logger.Info("persistent volume claim is still being resized")
-		resizingConditions.addCondition(metav1.Condition{
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
 			Type:    string(v1alpha1.ConditionTypeResizing),
 			Status:  metav1.ConditionTrue,
 			Reason:  ReasonReconcile,
-			Message: fmt.Sprintf("%s: is being scaled due to %s, persistent volume claim is still being resized", pvc.Name, scalingReason),
+			Message: resizingMessage(scalingReason, "persistent volume claim is still being resized"),
 		})
 
 		return true
@@ -705,10 +1466,23 @@ func (r *Runner) isResizeInProgress(logger logr.Logger, pvc *corev1.PersistentVo
 	return false
 }
 
+// resizingMessage returns the message of the Resizing condition of a PVC,
+// whose resize is in progress. The scaling reason is left out, when it is not
+// known.
+func resizingMessage(scalingReason, state string) string {
+	if scalingReason == "" {
+		return fmt.Sprintf("is being scaled, %s", state)
+	}
+
+	return fmt.Sprintf("is being scaled due to %s, %s", scalingReason, state)
+}
+
 // resizePVC performs the actual resize of the [corev1.PersistentVolumeClaim] targeted by the given
-// [v1alpha1.PersistentVolumeClaimAutoscaler].
-func (r *Runner) resizePVC(ctx context.Context, logger logr.Logger, pvc *corev1.PersistentVolumeClaim, policy v1alpha1.VolumePolicy, scalingReason string, volumeRecommendation v1alpha1.VolumeRecommendation, resizingConditions *resizingConditionAggregator) (v1alpha1.VolumeRecommendation, error) {
+// [v1alpha1.PersistentVolumeClaimAutoscaler]. costLimited reports whether the max capacity of the
+// policy has been limited by its max monthly cost.
+func (r *Runner) resizePVC(ctx context.Context, logger logr.Logger, pvca v1alpha1.Autoscaler, pvc *corev1.PersistentVolumeClaim, policy v1alpha1.VolumePolicy, costLimited bool, scalingReason string, volumeRecommendation v1alpha1.VolumeRecommendation, resizingConditions *resizingConditionAggregator) (v1alpha1.VolumeRecommendation, error) {
 	currSpecSize := pvc.Spec.Resources.Requests.Storage()
+	trigger := resizeTrigger(scalingReason)
 
 	// Calculate the new size
 	stepPercent := float64(*policy.ScaleUp.StepPercent)
@@ -734,6 +1508,12 @@ func (r *Runner) resizePVC(ctx context.Context, logger logr.Logger, pvc *corev1.
 		// Only clamp to max capacity if the increase is at least one scaling resolution,
 		// otherwise the increase is too small to be meaningful
 		if policy.MaxCapacity.Value()-currSpecSize.Value() < common.ScalingResolutionBytes {
+			if costLimited {
+				r.recordMaxMonthlyCostReached(logger, pvc, policy, resizingConditions)
+
+				return volumeRecommendation, nil
+			}
+
 			r.eventRecorder.Eventf(
 				pvc,
 				corev1.EventTypeWarning,
@@ -745,11 +1525,11 @@ func (r *Runner) resizePVC(ctx context.Context, logger logr.Logger, pvc *corev1.
 
 			if policy.ScaleUp.ResizeStrategy != v1alpha1.OffVolumeResizeStrategy {
 				metrics.MaxCapacityReachedTotal.WithLabelValues(pvc.Namespace, pvc.Name).Inc()
-				resizingConditions.addCondition(metav1.Condition{
+				resizingConditions.addPVCCondition(pvc, metav1.Condition{
 					Type:    string(v1alpha1.ConditionTypeResizing),
 					Status:  metav1.ConditionFalse,
 					Reason:  ReasonReconcile,
-					Message: fmt.Sprintf("%s: max capacity reached", pvc.Name),
+					Message: "max capacity reached",
 				})
 			}
 
@@ -765,27 +1545,121 @@ func (r *Runner) resizePVC(ctx context.Context, logger logr.Logger, pvc *corev1.
 		return volumeRecommendation, nil
 	}
 
-	if policy.ScaleUp.CooldownDuration != nil {
-		lastResizeTime := volumeRecommendation.LastResizeTime
-		if lastResizeTime != nil {
-			elapsed := time.Since(lastResizeTime.Time)
-			cooldown := policy.ScaleUp.CooldownDuration.Duration
-			if elapsed < cooldown {
-				remaining := cooldown - elapsed
-				logger.Info("cooldown period not elapsed", "remaining", remaining.String())
-				resizingConditions.addCondition(metav1.Condition{
-					Type:    string(v1alpha1.ConditionTypeResizing),
-					Status:  metav1.ConditionFalse,
-					Reason:  ReasonPVCResizeCooldown,
-					Message: fmt.Sprintf("%s: cooldown duration has not elapsed yet", pvc.Name),
-				})
+	if policy.ScaleUp.ResizeStrategy == v1alpha1.ManualVolumeResizeStrategy {
+		volumeRecommendation.Target.Size = targetSize
+		if !r.isResizeApproved(logger, pvca, pvc, policy, *targetSize, volumeRecommendation, resizingConditions) {
+			return volumeRecommendation, nil
+		}
+	}
 
-				return volumeRecommendation, nil
-			}
+	// The critical utilization threshold is only evaluated while the cooldown
+	// is active, since it is only relevant for bypassing the cooldown.
+	if policy.ScaleUp.CooldownDuration != nil && volumeRecommendation.LastResizeTime != nil {
+		elapsed := time.Since(volumeRecommendation.LastResizeTime.Time)
+		cooldown := policy.ScaleUp.CooldownDuration.Duration
+		if elapsed < cooldown && !r.isCriticalUtilizationReached(pvc, policy, volumeRecommendation) {
+			remaining := cooldown - elapsed
+			logger.Info("cooldown period not elapsed", "remaining", remaining.String())
+			resizingConditions.addPVCCondition(pvc, metav1.Condition{
+				Type:    string(v1alpha1.ConditionTypeResizing),
+				Status:  metav1.ConditionFalse,
+				Reason:  ReasonPVCResizeCooldown,
+				Message: "cooldown duration has not elapsed yet",
+			})
+
+			return volumeRecommendation, nil
 		}
 	}
 
+	volumeRecommendation, _, err := r.applyResize(ctx, logger, pvc, targetSize, trigger, scalingReason, "", volumeRecommendation, resizingConditions)
+
+	return volumeRecommendation, err
+}
+
+// applyResize resizes the [corev1.PersistentVolumeClaim] to the target size,
+// capped by the quotas and storage budgets, and records the resize in the
+// resize history with the given message. It returns whether the PVC has been
+// patched.
+func (r *Runner) applyResize(
+	ctx context.Context,
+	logger logr.Logger,
+	pvc *corev1.PersistentVolumeClaim,
+	targetSize *resource.Quantity,
+	trigger v1alpha1.ResizeTrigger,
+	scalingReason string,
+	historyMessage string,
+	volumeRecommendation v1alpha1.VolumeRecommendation,
+	resizingConditions *resizingConditionAggregator,
+) (v1alpha1.VolumeRecommendation, bool, error) {
+	currSpecSize := pvc.Spec.Resources.Requests.Storage()
+
+	// Make sure we stay within the quotas and storage budgets
+	allowedSize, limit, err := r.budgets.capToBudget(ctx, pvc, currSpecSize, targetSize)
+	if err != nil {
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonReconcile,
+			Message: fmt.Sprintf("could not determine storage quota: %s", err.Error()),
+		})
+
+		return volumeRecommendation, false, err
+	}
+
+	if allowedSize == nil {
+		r.recordQuotaExceeded(logger, pvc, currSpecSize, targetSize, *limit, resizingConditions)
+
+		return volumeRecommendation, false, nil
+	}
+
+	if limit != nil {
+		logger.Info("capping resize to storage quota", "limit", limit.String(), "size", allowedSize.String())
+		capped := fmt.Sprintf("capped by %s", limit)
+		scalingReason = fmt.Sprintf("%s, %s", scalingReason, capped)
+		if historyMessage == "" {
+			historyMessage = capped
+		} else {
+			historyMessage = fmt.Sprintf("%s, %s", historyMessage, capped)
+		}
+		targetSize = allowedSize
+	}
+
 	// And finally we should be good to resize now
+	if err := r.patchPVCSize(ctx, logger, pvc, targetSize); err != nil {
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonReconcile,
+			Message: fmt.Sprintf("could not patch PersistentVolumeClaim with new target size %s", targetSize.String()),
+		})
+		recordResize(&volumeRecommendation, *currSpecSize, *targetSize, trigger, v1alpha1.ResizeOutcomeFailed, err.Error())
+
+		return volumeRecommendation, false, err
+	}
+	r.budgets.consume(pvc, *targetSize, *currSpecSize)
+	volumeRecommendation.Target.Size = targetSize
+	volumeRecommendation.LastResizeTime = ptr.To(metav1.Now())
+	recordResize(&volumeRecommendation, *currSpecSize, *targetSize, trigger, v1alpha1.ResizeOutcomeInProgress, historyMessage)
+	// The utilization has to exceed the threshold for a whole stabilization window again
+	// before the next resize.
+	volumeRecommendation.ThresholdBreachStartTime = nil
+
+	resizingConditions.addPVCCondition(pvc, metav1.Condition{
+		Type:    string(v1alpha1.ConditionTypeResizing),
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonReconcile,
+		Message: fmt.Sprintf("resizing from %s to %s due to %s", currSpecSize.String(), targetSize.String(), scalingReason),
+	})
+
+	return volumeRecommendation, true, nil
+}
+
+// patchPVCSize patches the storage request of the [corev1.PersistentVolumeClaim]
+// to the given size. The size before the resize is recorded in the
+// [common.AnnotationPreviousSize] annotation.
+func (r *Runner) patchPVCSize(ctx context.Context, logger logr.Logger, pvc *corev1.PersistentVolumeClaim, targetSize *resource.Quantity) error {
+	currSpecSize := pvc.Spec.Resources.Requests.Storage()
+
 	logger.Info("resizing persistent volume claim", "from", currSpecSize.String(), "to", targetSize.String())
 	metrics.ResizedTotal.WithLabelValues(pvc.Namespace, pvc.Name).Inc()
 	r.eventRecorder.Eventf(
@@ -806,58 +1680,173 @@ func (r *Runner) resizePVC(ctx context.Context, logger logr.Logger, pvc *corev1.
 	pvc.Annotations[common.AnnotationPreviousSize] = currSpecSize.String()
 
 	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *targetSize
-	if err := r.client.Patch(ctx, pvc, pvcPatch); err != nil {
-		resizingConditions.addCondition(metav1.Condition{
-			Type:    string(v1alpha1.ConditionTypeResizing),
-			Status:  metav1.ConditionFalse,
-			Reason:  ReasonReconcile,
-			Message: fmt.Sprintf("%s: could not patch PersistentVolumeClaim with new target size %s", pvc.Name, targetSize.String()),
-		})
 
-		return volumeRecommendation, err
+	return r.client.Patch(ctx, pvc, pvcPatch)
+}
+
+// scaleUniformly resizes the [corev1.PersistentVolumeClaim] objects matched by
+// a volume policy with uniform scaling enabled up to the largest recommended
+// size within the group. PVCs which are already being resized are aligned once
+// their resize has completed.
+func (r *Runner) scaleUniformly(
+	ctx context.Context,
+	logger logr.Logger,
+	pvca v1alpha1.Autoscaler,
+	policy v1alpha1.VolumePolicy,
+	policyIndex int,
+	members []uniformScalingMember,
+	volumeRecommendations *[]v1alpha1.VolumeRecommendation,
+	resizingConditions *resizingConditionAggregator,
+) {
+	var largestSize *resource.Quantity
+	for _, member := range members {
+		targetSize := getOrCreateVolumeRecommendationForPVC(*volumeRecommendations, member.pvc).Target.Size
+		if targetSize != nil && (largestSize == nil || targetSize.Cmp(*largestSize) > 0) {
+			largestSize = targetSize
+		}
 	}
-	volumeRecommendation.Target.Size = targetSize
-	volumeRecommendation.LastResizeTime = ptr.To(metav1.Now())
 
-	resizingConditions.addCondition(metav1.Condition{
+	if largestSize == nil {
+		return
+	}
+
+	// The group must not grow beyond the max capacity of any of its members
+	for _, member := range members {
+		if largestSize.Cmp(member.maxCapacity) > 0 {
+			largestSize = &member.maxCapacity
+		}
+	}
+
+	for _, member := range members {
+		pvc := member.pvc
+		if pvc.Spec.Resources.Requests.Storage().Cmp(*largestSize) >= 0 {
+			continue
+		}
+
+		logger := logger.WithValues("pvc", client.ObjectKeyFromObject(pvc))
+		volumeRecommendation := getOrCreateVolumeRecommendationForPVC(*volumeRecommendations, pvc)
+		volumeRecommendation.Target.Size = ptr.To(largestSize.DeepCopy())
+
+		if policy.ScaleUp.ResizeStrategy != v1alpha1.OffVolumeResizeStrategy && !member.inProgress && !member.paused {
+			currSpecSize := pvc.Spec.Resources.Requests.Storage()
+
+			// The approval is checked for the aligned size, before it is
+			// compared with the quotas and storage budgets.
+			if policy.ScaleUp.ResizeStrategy == v1alpha1.ManualVolumeResizeStrategy && !r.isResizeApproved(logger, pvca, pvc, policy, *largestSize, volumeRecommendation, resizingConditions) {
+				setVolumeRecommendationForPVC(volumeRecommendations, pvc, volumeRecommendation)
+
+				continue
+			}
+
+			// Partial resizes would break the uniformity, so the PVC is only aligned when
+			// the whole increase fits into the quotas and storage budgets.
+			allowedSize, limit, err := r.budgets.capToBudget(ctx, pvc, currSpecSize, largestSize)
+			if err != nil {
+				logger.Error(err, "failed to determine storage quota for uniform scaling")
+
+				continue
+			}
+
+			if limit != nil {
+				if allowedSize == nil || allowedSize.Cmp(*largestSize) < 0 {
+					r.recordQuotaExceeded(logger, pvc, currSpecSize, largestSize, *limit, resizingConditions)
+
+					continue
+				}
+			}
+
+			if err := r.patchPVCSize(ctx, logger, pvc, largestSize); err != nil {
+				logger.Error(err, "failed to resize pvc for uniform scaling")
+				resizingConditions.addPVCCondition(pvc, metav1.Condition{
+					Type:    string(v1alpha1.ConditionTypeResizing),
+					Status:  metav1.ConditionFalse,
+					Reason:  ReasonUniformScaling,
+					Message: fmt.Sprintf("could not patch PersistentVolumeClaim with new target size %s", largestSize.String()),
+				})
+				recordResize(&volumeRecommendation, *currSpecSize, *largestSize, v1alpha1.ResizeTriggerUniformScaling, v1alpha1.ResizeOutcomeFailed, err.Error())
+				setVolumeRecommendationForPVC(volumeRecommendations, pvc, volumeRecommendation)
+
+				continue
+			}
+
+			r.budgets.consume(pvc, *largestSize, *currSpecSize)
+			volumeRecommendation.LastResizeTime = ptr.To(metav1.Now())
+			recordResize(&volumeRecommendation, *currSpecSize, *largestSize, v1alpha1.ResizeTriggerUniformScaling, v1alpha1.ResizeOutcomeInProgress, "")
+			resizingConditions.addPVCCondition(pvc, metav1.Condition{
+				Type:    string(v1alpha1.ConditionTypeResizing),
+				Status:  metav1.ConditionTrue,
+				Reason:  ReasonUniformScaling,
+				Message: fmt.Sprintf("resizing to %s to match the largest PersistentVolumeClaim of volume policy %d", largestSize.String(), policyIndex),
+			})
+		}
+
+		setVolumeRecommendationForPVC(volumeRecommendations, pvc, volumeRecommendation)
+	}
+}
+
+// recordQuotaExceeded reports that the resize of the
+// [corev1.PersistentVolumeClaim] has been skipped, because it would exceed the
+// given quota or storage budget.
+func (r *Runner) recordQuotaExceeded(logger logr.Logger, pvc *corev1.PersistentVolumeClaim, currSize, targetSize *resource.Quantity, limit budgetKey, resizingConditions *resizingConditionAggregator) {
+	logger.Info("storage quota exceeded", "limit", limit.String())
+	metrics.QuotaExceededTotal.WithLabelValues(pvc.Namespace, pvc.Name).Inc()
+	r.eventRecorder.Eventf(
+		pvc,
+		corev1.EventTypeWarning,
+		"QuotaExceeded",
+		"resizing storage from %s to %s would exceed the %s",
+		currSize.String(),
+		targetSize.String(),
+		limit.String(),
+	)
+	resizingConditions.addPVCCondition(pvc, metav1.Condition{
 		Type:    string(v1alpha1.ConditionTypeResizing),
-		Status:  metav1.ConditionTrue,
-		Reason:  ReasonReconcile,
-		Message: fmt.Sprintf("%s: resizing from %s to %s due to %s", pvc.Name, currSpecSize.String(), targetSize.String(), scalingReason),
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonQuotaExceeded,
+		Message: fmt.Sprintf("resizing to %s would exceed the %s", targetSize.String(), limit),
 	})
-
-	return volumeRecommendation, nil
 }
 
 // setStatus updates the status of the [v1alpha1.PersistentVolumeClaimAutoscaler]
-// with the given conditions and the latest volume recommendations. For each
-// condition, an empty Message is treated as a sentinel value: the condition is
-// removed from the status by Type rather than set. The status is only patched
-// if the recommendations, resizing conditions, or current stats have changed
-// compared to the existing status.
-func (r *Runner) setStatus(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler, recommendationsCondition metav1.Condition, resizingCondition metav1.Condition, volumeRecommendations []v1alpha1.VolumeRecommendation) error {
-	original := pvca.DeepCopy()
-	conditions := pvca.Status.Conditions
-	if len(conditions) == 0 {
-		conditions = make([]metav1.Condition, 0)
-	}
-
-	for _, condition := range []metav1.Condition{resizingCondition, recommendationsCondition} {
+// with the given conditions, the latest volume recommendations, the summary of
+// its PVCs and the time of its next scheduled check. For each condition, an
+// empty Message is treated as a sentinel value: the condition is removed from
+// the status by Type rather than set. The conditions and the status are marked
+// with the generation of the autoscaler, which has been observed. The status is
+// only patched if it has changed compared to the existing status.
+func (r *Runner) setStatus(ctx context.Context, pvca v1alpha1.Autoscaler, conditions []metav1.Condition, volumeRecommendations []v1alpha1.VolumeRecommendation, summary statusSummary) error {
+	original := pvca.DeepCopyObject().(v1alpha1.Autoscaler)
+	status := pvca.GetAutoscalerStatus()
+	statusConditions := status.Conditions
+	if len(statusConditions) == 0 {
+		statusConditions = make([]metav1.Condition, 0)
+	}
+
+	for _, condition := range conditions {
 		if condition.Message == "" {
-			meta.RemoveStatusCondition(&conditions, condition.Type)
+			meta.RemoveStatusCondition(&statusConditions, condition.Type)
 		} else {
-			meta.SetStatusCondition(&conditions, condition)
+			condition.ObservedGeneration = pvca.GetGeneration()
+			meta.SetStatusCondition(&statusConditions, condition)
 		}
 	}
 
-	pvca.Status.Conditions = conditions
+	status.Conditions = statusConditions
+	status.ObservedGeneration = pvca.GetGeneration()
+	summary.apply(status)
+	if at, ok := r.schedule.get(client.ObjectKeyFromObject(pvca)); ok {
+		status.NextCheckTime = ptr.To(metav1.NewTime(at.Truncate(time.Second)))
+	}
 
 	slices.SortFunc(volumeRecommendations, func(vr1, vr2 v1alpha1.VolumeRecommendation) int {
-		return strings.Compare(vr1.Name, vr2.Name)
+		return cmp.Or(
+			strings.Compare(vr1.Namespace, vr2.Namespace),
+			strings.Compare(vr1.Name, vr2.Name),
+		)
 	})
-	pvca.Status.VolumeRecommendations = volumeRecommendations
+	status.VolumeRecommendations = volumeRecommendations
 
-	if apiequality.Semantic.DeepEqual(original.Status, pvca.Status) {
+	if apiequality.Semantic.DeepEqual(*original.GetAutoscalerStatus(), *status) {
 		return nil
 	}
 
@@ -98,6 +98,7 @@ var _ = BeforeSuite(func() {
 	mgrClient = mgr.GetClient()
 
 	Expect(v1alpha1.AddAutoscalerNameFieldIndexer(context.Background(), mgr.GetFieldIndexer())).To(Succeed())
+	Expect(AddStorageClassFieldIndexer(context.Background(), mgr.GetFieldIndexer())).To(Succeed())
 
 	go func() {
 		defer GinkgoRecover()
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
@@ -19,9 +20,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -99,6 +103,27 @@ func newRunner() (*Runner, error) {
 	return runner, err
 }
 
+// registerPVCMetrics configures the runner with a metrics source reporting
+// metrics for the given PVCs.
+func registerPVCMetrics(runner *Runner, pvcs ...*corev1.PersistentVolumeClaim) {
+	metricsSource := fake.New(fake.WithInterval(10 * time.Millisecond))
+	for _, pvc := range pvcs {
+		metricsSource.Register(&fake.Item{
+			NamespacedName:  client.ObjectKeyFromObject(pvc),
+			CapacityBytes:   1073741824,
+			AvailableBytes:  1073741824,
+			CapacityInodes:  10000,
+			AvailableInodes: 10000,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	DeferCleanup(cancel)
+	metricsSource.Start(ctx)
+
+	WithMetricsSource(metricsSource)(runner)
+}
+
 // createPodWithPVC creates a Pod in the "default" namespace with the given
 // labels and a single PVC volume referencing claimName. The Pod is registered
 // for cleanup via DeferCleanup.
@@ -186,6 +211,10 @@ var _ = Describe("Periodic Runner", func() {
 	})
 
 	Context("getVolumePolicy", func() {
+		newNamedPVC := func(name string) *corev1.PersistentVolumeClaim {
+			return &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		}
+
 		It("should return error on invalid glob pattern", func() {
 			volumePolicies := []v1alpha1.VolumePolicy{
 				{
@@ -196,7 +225,7 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("data-pvc", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("invalid volume policy name \"[\""))
 			Expect(policy).To(BeNil())
@@ -212,7 +241,7 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("data-pvc", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policy).To(BeNil())
 		})
@@ -227,7 +256,7 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("data-pvc", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policy).NotTo(BeNil())
 			Expect(policy.Match.Name).To(Equal("data-pvc"))
@@ -243,7 +272,7 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("app-logs", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("app-logs"), "", volumePolicies)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policy).NotTo(BeNil())
 			Expect(policy.Match.Name).To(Equal("*-logs"))
@@ -259,7 +288,7 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("data-pvc", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policy).NotTo(BeNil())
 			Expect(policy.Match.Name).To(Equal("*"))
@@ -281,7 +310,7 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("data-pvc", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policy).NotTo(BeNil())
 			Expect(policy.Match.Name).To(Equal("*"))
@@ -310,12 +339,135 @@ var _ = Describe("Periodic Runner", func() {
 				},
 			}
 
-			policy, err := getVolumePolicy("data-pvc", volumePolicies)
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policy).NotTo(BeNil())
 			Expect(policy.Match.Name).To(Equal("data-*"))
 			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("10Gi")))
 		})
+
+		It("should match the label selector together with the name", func() {
+			volumePolicies := []v1alpha1.VolumePolicy{
+				{
+					Match: v1alpha1.Match{
+						Name:     "other-*",
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"volume-role": "wal"}},
+					},
+					MaxCapacity: resource.MustParse("30Gi"),
+				},
+				{
+					Match: v1alpha1.Match{
+						Name:     "data-*",
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"volume-role": "wal"}},
+					},
+					MaxCapacity: resource.MustParse("20Gi"),
+				},
+				{
+					Match: v1alpha1.Match{
+						Name: "*",
+					},
+					MaxCapacity: resource.MustParse("5Gi"),
+				},
+			}
+
+			pvc := newNamedPVC("data-pvc")
+			pvc.Labels = map[string]string{"volume-role": "wal"}
+			policy, index, err := getVolumePolicy(pvc, "", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("20Gi")))
+			Expect(index).To(Equal(1))
+
+			pvc.Labels = map[string]string{"volume-role": "data"}
+			policy, index, err = getVolumePolicy(pvc, "", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("5Gi")))
+			Expect(index).To(Equal(2))
+		})
+
+		It("should match the volume claim template", func() {
+			volumePolicies := []v1alpha1.VolumePolicy{
+				{
+					Match: v1alpha1.Match{
+						Name:                "*",
+						VolumeClaimTemplate: "data",
+					},
+					MaxCapacity: resource.MustParse("10Gi"),
+				},
+				{
+					Match: v1alpha1.Match{
+						Name:                "*",
+						VolumeClaimTemplate: "data-wal",
+					},
+					MaxCapacity: resource.MustParse("20Gi"),
+				},
+			}
+
+			policy, _, err := getVolumePolicy(newNamedPVC("data-wal-sts-0"), "data-wal", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("20Gi")))
+
+			policy, _, err = getVolumePolicy(newNamedPVC("data-sts-0"), "", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).To(BeNil())
+		})
+
+		It("should match the storage class", func() {
+			volumePolicies := []v1alpha1.VolumePolicy{
+				{
+					Match: v1alpha1.Match{
+						Name:             "*",
+						StorageClassName: "premium-ssd",
+					},
+					MaxCapacity: resource.MustParse("10Gi"),
+				},
+				{
+					Match: v1alpha1.Match{
+						Name: "*",
+					},
+					MaxCapacity: resource.MustParse("20Gi"),
+				},
+			}
+
+			pvc := newNamedPVC("data-0")
+			pvc.Spec.StorageClassName = ptr.To("premium-ssd")
+			policy, _, err := getVolumePolicy(pvc, "", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("10Gi")))
+
+			pvc.Spec.StorageClassName = ptr.To("standard-hdd")
+			policy, _, err = getVolumePolicy(pvc, "", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("20Gi")))
+
+			pvc.Spec.StorageClassName = nil
+			policy, _, err = getVolumePolicy(pvc, "", volumePolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.MaxCapacity).To(Equal(resource.MustParse("20Gi")))
+		})
+
+		It("should return error on invalid label selector", func() {
+			volumePolicies := []v1alpha1.VolumePolicy{
+				{
+					Match: v1alpha1.Match{
+						Name: "*",
+						Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "tier", Operator: "Invalid"},
+						}},
+					},
+					MaxCapacity: resource.MustParse("10Gi"),
+				},
+			}
+
+			policy, _, err := getVolumePolicy(newNamedPVC("data-pvc"), "", volumePolicies)
+			Expect(err).To(MatchError(ContainSubstring("invalid volume policy selector")))
+			Expect(policy).To(BeNil())
+		})
 	})
 
 	Context("With runner instance", func() {
@@ -339,7 +491,7 @@ var _ = Describe("Periodic Runner", func() {
 						UtilizationThresholdPercent: ptr.To(common.DefaultThresholdPercent),
 						StepPercent:                 ptr.To(common.DefaultStepPercent),
 						MinStepAbsolute:             ptr.To(resource.MustParse("1Gi")),
-						CooldownDuration:            ptr.To(metav1.Duration{Duration: 3600}),
+						CooldownDuration:            ptr.To(metav1.Duration{Duration: time.Hour}),
 					}),
 				},
 			}
@@ -499,7 +651,7 @@ var _ = Describe("Periodic Runner", func() {
 					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
 				})
 
-				volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(volumePolicy).NotTo(BeNil())
 				err = runner.validatePVC(parentCtx, pvc, *volumePolicy)
@@ -552,7 +704,7 @@ var _ = Describe("Periodic Runner", func() {
 					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
 				})
 
-				volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(volumePolicy).NotTo(BeNil())
 				err = runner.validatePVC(parentCtx, pvc, *volumePolicy)
@@ -586,7 +738,7 @@ var _ = Describe("Periodic Runner", func() {
 					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
 				})
 
-				volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(volumePolicy).NotTo(BeNil())
 				err = runner.validatePVC(parentCtx, pvc, *volumePolicy)
@@ -599,7 +751,7 @@ var _ = Describe("Periodic Runner", func() {
 				pvc.Status.Phase = corev1.ClaimLost
 				Expect(k8sClient.Status().Patch(parentCtx, pvc, patch)).To(Succeed())
 
-				volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(volumePolicy).NotTo(BeNil())
 				err = runner.validatePVC(parentCtx, pvc, *volumePolicy)
@@ -617,7 +769,7 @@ var _ = Describe("Periodic Runner", func() {
 					},
 				}
 
-				volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(volumePolicy).NotTo(BeNil())
 
@@ -650,7 +802,7 @@ var _ = Describe("Periodic Runner", func() {
 						},
 					}
 
-					volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+					volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(volumePolicy).NotTo(BeNil())
 
@@ -672,7 +824,7 @@ var _ = Describe("Periodic Runner", func() {
 						},
 					}
 
-					volumePolicy, err := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+					volumePolicy, _, err := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(volumePolicy).NotTo(BeNil())
 
@@ -687,6 +839,96 @@ var _ = Describe("Periodic Runner", func() {
 			})
 		})
 
+		Describe("#recordThresholdBreach", func() {
+			It("should record the breach start time when the threshold is first exceeded", func() {
+				volumeRecommendation := v1alpha1.VolumeRecommendation{Name: pvc.Name}
+				before := time.Now()
+				recordThresholdBreach(&volumeRecommendation, true)
+				Expect(volumeRecommendation.ThresholdBreachStartTime).NotTo(BeNil())
+				Expect(volumeRecommendation.ThresholdBreachStartTime.Time).To(BeTemporally("~", before, time.Second))
+			})
+
+			It("should keep the breach start time while the threshold stays exceeded", func() {
+				breachStart := metav1.NewTime(time.Now().Add(-time.Hour))
+				volumeRecommendation := v1alpha1.VolumeRecommendation{Name: pvc.Name, ThresholdBreachStartTime: &breachStart}
+				recordThresholdBreach(&volumeRecommendation, true)
+				Expect(volumeRecommendation.ThresholdBreachStartTime).To(Equal(&breachStart))
+			})
+
+			It("should clear the breach start time when the threshold is no longer exceeded", func() {
+				breachStart := metav1.NewTime(time.Now().Add(-time.Hour))
+				volumeRecommendation := v1alpha1.VolumeRecommendation{Name: pvc.Name, ThresholdBreachStartTime: &breachStart}
+				recordThresholdBreach(&volumeRecommendation, false)
+				Expect(volumeRecommendation.ThresholdBreachStartTime).To(BeNil())
+			})
+		})
+
+		Describe("#isVolumeRecommendationOutdated", func() {
+			DescribeTable("should detect recommendations of earlier PVCs with the same name",
+				func(uid types.UID, expectOutdated bool) {
+					pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pod-0-scratch", UID: "pvc-uid"}}
+					volumeRecommendation := v1alpha1.VolumeRecommendation{Name: pvc.Name, UID: uid}
+
+					Expect(isVolumeRecommendationOutdated(volumeRecommendation, pvc)).To(Equal(expectOutdated))
+				},
+				Entry("should not be outdated without a UID", types.UID(""), false),
+				Entry("should not be outdated when recorded for the PVC", types.UID("pvc-uid"), false),
+				Entry("should be outdated when recorded for an earlier PVC", types.UID("earlier-pvc-uid"), true),
+			)
+		})
+
+		Describe("#isStabilizationWindowElapsed", func() {
+			var (
+				logger     logr.Logger
+				aggregator *resizingConditionAggregator
+				policy     v1alpha1.VolumePolicy
+			)
+
+			BeforeEach(func() {
+				logger = zap.New(zap.WriteTo(GinkgoWriter))
+				aggregator = &resizingConditionAggregator{}
+				policy = *defaultVolumePolicies[0].DeepCopy()
+				policy.ScaleUp.StabilizationWindow = &metav1.Duration{Duration: 10 * time.Minute}
+			})
+
+			DescribeTable("should evaluate the stabilization window",
+				func(breachOffset time.Duration, usedSpacePercent int, critical *int, expectElapsed bool) {
+					breachStart := metav1.NewTime(time.Now().Add(breachOffset))
+					policy.ScaleUp.CriticalUtilizationPercent = critical
+					volumeRecommendation := v1alpha1.VolumeRecommendation{
+						Name:                     pvc.Name,
+						Current:                  v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(usedSpacePercent)},
+						ThresholdBreachStartTime: &breachStart,
+					}
+
+					Expect(runner.isStabilizationWindowElapsed(logger, pvc, policy, volumeRecommendation, aggregator)).To(Equal(expectElapsed))
+					if expectElapsed {
+						Expect(aggregator.getAggregatedCondition().Message).To(BeEmpty())
+					} else {
+						Expect(aggregator.getAggregatedCondition()).To(And(
+							HaveField("Type", string(v1alpha1.ConditionTypeResizing)),
+							HaveField("Status", metav1.ConditionFalse),
+							HaveField("Reason", ReasonPVCResizeStabilization),
+						))
+					}
+				},
+				Entry("should not be elapsed right after the threshold was exceeded", time.Duration(0), 85, nil, false),
+				Entry("should be elapsed after the threshold was exceeded for the whole window", -20*time.Minute, 85, nil, true),
+				Entry("should be elapsed when the critical threshold is exceeded", time.Duration(0), 99, ptr.To(95), true),
+			)
+
+			It("should always be elapsed when no stabilization window is configured", func() {
+				policy.ScaleUp.StabilizationWindow = nil
+				volumeRecommendation := v1alpha1.VolumeRecommendation{
+					Name:                     pvc.Name,
+					Current:                  v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(85)},
+					ThresholdBreachStartTime: ptr.To(metav1.Now()),
+				}
+
+				Expect(runner.isStabilizationWindowElapsed(logger, pvc, policy, volumeRecommendation, aggregator)).To(BeTrue())
+			})
+		})
+
 		Describe("#reconcileAll", func() {
 			It("should not reconcile when PVCA targets non-existent PVC", func() {
 				By("Patching PVCA to target a non-existent PVC")
@@ -790,36 +1032,133 @@ var _ = Describe("Periodic Runner", func() {
 					HaveField("Status", metav1.ConditionTrue),
 					HaveField("Reason", ReasonRecommendationsProvided),
 				)))
+				Expect(updatedPVCA.Status.VolumeRecommendations).To(ConsistOf(And(
+					HaveField("VolumePolicyIndex", Equal(ptr.To(0))),
+					HaveField("Conditions", ContainElement(And(
+						HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
+						HaveField("Status", metav1.ConditionTrue),
+						HaveField("Reason", ReasonMetricsFetched),
+					))),
+				)))
 			})
 
-			It("should set RecommendationAvailable condition to false and not enqueue when two PVCAs manage the same PVC", func() {
-				By("Creating PVCA that points to a PVC already managed by a different PVCA")
-				conflictingPVCA := createPVCA(parentCtx, "pvca-with-conflict", "", pvca.Spec.TargetRef, pvca.Spec.VolumePolicies)
-				DeferCleanup(func() {
-					By("Deleting conflicting PVCA")
-					Expect(testutils.CleanupObject(parentCtx, k8sClient, conflictingPVCA)).To(Succeed())
-					Eventually(func() error {
-						return k8sClient.Get(parentCtx, client.ObjectKeyFromObject(conflictingPVCA), conflictingPVCA)
-					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+			It("should provide recommendations without resizing the PVC when the PVCA is suspended", func() {
+				pvcaPatch := client.MergeFrom(pvca.DeepCopy())
+				pvca.Spec.Suspend = true
+				Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
+				waitForPVCACacheSync(parentCtx, pvca)
+
+				metricsSource := fake.New()
+				metricsSource.Register(&fake.Item{
+					NamespacedName:  client.ObjectKeyFromObject(pvc),
+					CapacityBytes:   1073741824,
+					AvailableBytes:  10737418,
+					CapacityInodes:  10000,
+					AvailableInodes: 10000,
 				})
+				withMetricsSourceOpt := WithMetricsSource(metricsSource)
+				withMetricsSourceOpt(runner)
 
 				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
 
-				By("Verifying the RecommendationAvailable conditions for both PVCAs")
-				for _, pvca := range []*v1alpha1.PersistentVolumeClaimAutoscaler{pvca, conflictingPVCA} {
-					updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
-					Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed(), "should successfully fetch pvca "+client.ObjectKeyFromObject(pvca).String())
-					Expect(updatedPVCA.Status.Conditions).To(ContainElement(And(
+				var pvcObj corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+				Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+
+				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
+				Expect(updatedPVCA.Status.Conditions).To(ContainElements(
+					And(
 						HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
-						HaveField("Status", metav1.ConditionFalse),
-						HaveField("Reason", ReasonAmbiguousPVCA),
-					)), client.ObjectKeyFromObject(pvca).String()+" pvca should have expected condition")
+						HaveField("Status", metav1.ConditionTrue),
+					),
+					And(
+						HaveField("Type", string(v1alpha1.ConditionTypeSuspended)),
+						HaveField("Status", metav1.ConditionTrue),
+						HaveField("Reason", ReasonSuspended),
+					),
+				))
+				Expect(updatedPVCA.Status.VolumeRecommendations).To(ConsistOf(
+					HaveField("Current.UsedSpacePercent", Not(BeNil())),
+				))
+
+				By("Resuming the PVCA")
+				pvcaPatch = client.MergeFrom(updatedPVCA.DeepCopy())
+				updatedPVCA.Spec.Suspend = false
+				Expect(k8sClient.Patch(parentCtx, updatedPVCA, pvcaPatch)).To(Succeed())
+				waitForPVCACacheSync(parentCtx, updatedPVCA)
+
+				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
+				Expect(updatedPVCA.Status.Conditions).NotTo(ContainElement(
+					HaveField("Type", string(v1alpha1.ConditionTypeSuspended)),
+				))
+			})
+
+			It("should not resize a PVC which is paused via annotation", func() {
+				pvcPatch := client.MergeFrom(pvc.DeepCopy())
+				metav1.SetMetaDataAnnotation(&pvc.ObjectMeta, common.AnnotationPaused, "true")
+				Expect(k8sClient.Patch(parentCtx, pvc, pvcPatch)).To(Succeed())
+
+				metricsSource := fake.New()
+				metricsSource.Register(&fake.Item{
+					NamespacedName:  client.ObjectKeyFromObject(pvc),
+					CapacityBytes:   1073741824,
+					AvailableBytes:  10737418,
+					CapacityInodes:  10000,
+					AvailableInodes: 10000,
+				})
+				withMetricsSourceOpt := WithMetricsSource(metricsSource)
+				withMetricsSourceOpt(runner)
+
+				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
+
+				var pvcObj corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+				Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+
+				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
+				Expect(updatedPVCA.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", string(v1alpha1.ConditionTypePaused)),
+					HaveField("Status", metav1.ConditionTrue),
+					HaveField("Reason", ReasonPVCsPaused),
+					HaveField("Message", Equal("Resizing is paused for PersistentVolumeClaims: "+pvc.Name)),
+				)))
+				Expect(updatedPVCA.Status.Conditions).NotTo(ContainElement(
+					HaveField("Type", string(v1alpha1.ConditionTypeSuspended)),
+				))
+			})
+
+			It("should execute a resize request for a managed PVC", func() {
+				request := &v1alpha1.PersistentVolumeClaimResizeRequest{
+					ObjectMeta: metav1.ObjectMeta{Name: "grow-pvc", Namespace: pvc.Namespace},
+					Spec: v1alpha1.PersistentVolumeClaimResizeRequestSpec{
+						ClaimName: pvc.Name,
+						Size:      resource.MustParse("3Gi"),
+						Reason:    "bulk import",
+					},
 				}
+				Expect(k8sClient.Create(parentCtx, request)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(testutils.CleanupObject(parentCtx, k8sClient, request)).To(Succeed())
+				})
+
+				registerPVCMetrics(runner, pvc)
+				Eventually(func(g Gomega) {
+					g.Expect(runner.reconcileAll(parentCtx)).To(Succeed())
+					g.Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(request), request)).To(Succeed())
+					g.Expect(request.Status.Phase).To(Equal(v1alpha1.ResizeRequestPhaseInProgress))
+				}).Should(Succeed())
+
+				var pvcObj corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+				Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("3Gi")))
 			})
 
-			It("should set RecommendationAvailable condition to true when conflict is resolved", func() {
+			It("should let the oldest PVCA manage a PVC selected by two PVCAs", func() {
 				By("Creating PVCA that points to a PVC already managed by a different PVCA")
-				conflictingPVCA := createPVCA(parentCtx, "pvca-with-conflict", "", pvca.Spec.TargetRef, pvca.Spec.VolumePolicies)
+				conflictingPVCA := createPVCA(parentCtx, "test-pvca-with-conflict", "", pvca.Spec.TargetRef, pvca.Spec.VolumePolicies)
 				DeferCleanup(func() {
 					By("Deleting conflicting PVCA")
 					Expect(testutils.CleanupObject(parentCtx, k8sClient, conflictingPVCA)).To(Succeed())
@@ -828,30 +1167,69 @@ var _ = Describe("Periodic Runner", func() {
 					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
 				})
 
+				registerPVCMetrics(runner, pvc)
 				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
 
-				By("Verifying pvca's RecommendationAvailable condition has AmbiguousPersistentVolumeClaimAutoscaler reason")
+				By("Verifying the PVC is only managed by the oldest PVCA")
 				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
 				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
-				Expect(updatedPVCA.Status.Conditions).To(ContainElement(And(
+				Expect(updatedPVCA.Status.VolumeRecommendations).To(ConsistOf(And(
+					HaveField("Name", pvc.Name),
+					HaveField("Source", "PersistentVolumeClaimAutoscaler/"+pvca.Name),
+				)))
+
+				updatedConflictingPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(conflictingPVCA), updatedConflictingPVCA)).To(Succeed())
+				Expect(updatedConflictingPVCA.Status.VolumeRecommendations).To(BeEmpty())
+				Expect(updatedConflictingPVCA.Status.Conditions).To(ContainElement(And(
 					HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
-					HaveField("Status", metav1.ConditionFalse),
-					HaveField("Reason", ReasonAmbiguousPVCA),
+					HaveField("Status", metav1.ConditionTrue),
 				)))
+			})
 
-				By("Deleting conflicting pvca")
-				Expect(testutils.CleanupObject(parentCtx, k8sClient, conflictingPVCA)).To(Succeed())
+			It("should let a PVCA take precedence over a ClusterPersistentVolumeClaimAutoscaler", func() {
+				By("Creating a ClusterPersistentVolumeClaimAutoscaler selecting all PVCs")
+				cpvca := &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cpvca"},
+					Spec: v1alpha1.ClusterPersistentVolumeClaimAutoscalerSpec{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{corev1.LabelMetadataName: pvc.Namespace},
+						},
+						VolumePolicies: pvca.Spec.VolumePolicies,
+					},
+				}
+				Expect(k8sClient.Create(parentCtx, cpvca)).To(Succeed())
+				DeferCleanup(func() {
+					By("Deleting ClusterPersistentVolumeClaimAutoscaler")
+					Expect(testutils.CleanupObject(parentCtx, k8sClient, cpvca)).To(Succeed())
+				})
 				Eventually(func() error {
-					return k8sClient.Get(parentCtx, client.ObjectKeyFromObject(conflictingPVCA), conflictingPVCA)
-				}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+					return mgrClient.Get(parentCtx, client.ObjectKeyFromObject(cpvca), &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{})
+				}).Should(Succeed())
 
+				registerPVCMetrics(runner, pvc)
 				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
 
-				By("Verifying the RecommendationAvailable condition no longer has AmbiguousPersistentVolumeClaimAutoscaler reason")
-				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
-				Expect(updatedPVCA.Status.Conditions).To(ContainElement(And(
-					HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
-					HaveField("Reason", Not(Equal(ReasonAmbiguousPVCA))),
+				By("Verifying the PVC is managed by the PVCA")
+				updatedCPVCA := &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(cpvca), updatedCPVCA)).To(Succeed())
+				Expect(updatedCPVCA.Status.VolumeRecommendations).NotTo(ContainElement(HaveField("Name", pvc.Name)))
+
+				By("Patching PVCA to target a non-existent PVC")
+				pvcaPatch := client.MergeFrom(pvca.DeepCopy())
+				pvca.Spec.TargetRef.Name = nonExistentPVCName
+				Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
+				waitForPVCACacheSync(parentCtx, pvca)
+
+				registerPVCMetrics(runner, pvc)
+				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
+
+				By("Verifying the PVC is managed by the ClusterPersistentVolumeClaimAutoscaler")
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(cpvca), updatedCPVCA)).To(Succeed())
+				Expect(updatedCPVCA.Status.VolumeRecommendations).To(ContainElement(And(
+					HaveField("Name", pvc.Name),
+					HaveField("Namespace", pvc.Namespace),
+					HaveField("Source", "ClusterPersistentVolumeClaimAutoscaler/"+cpvca.Name),
 				)))
 			})
 
@@ -1138,6 +1516,32 @@ var _ = Describe("Periodic Runner", func() {
 					names = append(names, vr.Name)
 				}
 				Expect(names).To(ConsistOf(pvcA.Name, pvcB.Name))
+				Expect(updatedPVCA.Status.VolumeRecommendations).To(HaveEach(
+					HaveField("TargetRef", Equal(&pvca.Spec.TargetRef)),
+				))
+			})
+
+			It("should set NoPVCsMatched when no targets match the targetSelector", func() {
+				By("Patching the PVCA to select targets by label")
+				pvcaPatch := client.MergeFrom(pvca.DeepCopy())
+				pvca.Spec.TargetSelector = &v1alpha1.TargetSelector{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Selector:   metav1.LabelSelector{MatchLabels: map[string]string{"tier": "no-matching-targets"}},
+				}
+				Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
+				waitForPVCACacheSync(parentCtx, pvca)
+
+				Expect(runner.reconcileAll(parentCtx)).To(Succeed())
+
+				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
+				Expect(updatedPVCA.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Reason", ReasonNoPVCsMatched),
+					HaveField("Message", "No PersistentVolumeClaims found for PersistentVolumeClaimAutoscaler: no targets match the targetSelector of PersistentVolumeClaimAutoscaler"),
+				)))
 			})
 
 			It("should set NoPVCsMatched when targeting a Deployment with no matching pods", func() {
@@ -1400,6 +1804,67 @@ var _ = Describe("Periodic Runner", func() {
 			})
 		})
 
+		Describe("#reconcileOne", func() {
+			It("should not resize a PVC based on the metrics of the latest periodic reconciliation", func() {
+				runner.metricsData = metricssource.Metrics{
+					client.ObjectKeyFromObject(pvc): {
+						CapacityBytes:   1073741824,
+						AvailableBytes:  10737418,
+						CapacityInodes:  10000,
+						AvailableInodes: 10000,
+					},
+				}
+
+				Expect(runner.reconcileOne(parentCtx, client.ObjectKeyFromObject(pvca))).To(Succeed())
+
+				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
+				Expect(updatedPVCA.Status.VolumeRecommendations).To(ConsistOf(And(
+					HaveField("Name", pvc.Name),
+					HaveField("Current.UsedSpacePercent", Not(BeNil())),
+					HaveField("LastResizeTime", BeNil()),
+					HaveField("ThresholdBreachStartTime", BeNil()),
+				)))
+
+				var pvcObj corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+				Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+			})
+
+			It("should execute a resize request for a managed PVC", func() {
+				request := &v1alpha1.PersistentVolumeClaimResizeRequest{
+					ObjectMeta: metav1.ObjectMeta{Name: "grow-pvc", Namespace: pvc.Namespace},
+					Spec: v1alpha1.PersistentVolumeClaimResizeRequestSpec{
+						ClaimName: pvc.Name,
+						Size:      resource.MustParse("3Gi"),
+						Reason:    "bulk import",
+					},
+				}
+				Expect(k8sClient.Create(parentCtx, request)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(testutils.CleanupObject(parentCtx, k8sClient, request)).To(Succeed())
+				})
+
+				runner.metricsData = metricssource.Metrics{
+					client.ObjectKeyFromObject(pvc): {
+						CapacityBytes:   1073741824,
+						AvailableBytes:  1073741824,
+						CapacityInodes:  10000,
+						AvailableInodes: 10000,
+					},
+				}
+				Eventually(func(g Gomega) {
+					g.Expect(runner.reconcileOne(parentCtx, client.ObjectKeyFromObject(pvca))).To(Succeed())
+					g.Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(request), request)).To(Succeed())
+					g.Expect(request.Status.Phase).To(Equal(v1alpha1.ResizeRequestPhaseInProgress))
+				}).Should(Succeed())
+
+				var pvcObj corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+				Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("3Gi")))
+			})
+		})
+
 		Describe("Start", func() {
 			It("should fail to reconcile when metrics source always returns errors", func() {
 				withMetricsSourceOpt := WithMetricsSource(&fake.AlwaysFailing{})
@@ -1481,10 +1946,19 @@ var _ = Describe("Periodic Runner", func() {
 					true,
 					metav1.ConditionTrue,
 				),
-				Entry("should detect filesystem resize is pending",
-					ptr.To(corev1.PersistentVolumeClaimFileSystemResizePending),
+				Entry("should leave out an unknown scaling reason",
+					ptr.To(corev1.PersistentVolumeClaimResizing),
 					nil,
-					"passing inodes threshold",
+					"",
+					"resize has been started",
+					`^is being scaled, resize has been started$`,
+					true,
+					metav1.ConditionTrue,
+				),
+				Entry("should detect filesystem resize is pending",
+					ptr.To(corev1.PersistentVolumeClaimFileSystemResizePending),
+					nil,
+					"passing inodes threshold",
 					"filesystem resize is pending",
 					`passing inodes threshold.*file system resize is pending`,
 					true,
@@ -1561,9 +2035,9 @@ var _ = Describe("Periodic Runner", func() {
 					logger := zap.New(zap.WriteTo(w))
 
 					aggregator := &resizingConditionAggregator{}
-					volumePolicy, errPolicy := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+					volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 					Expect(errPolicy).NotTo(HaveOccurred())
-					updatedRecommendation, err := runner.resizePVC(parentCtx, logger, pvc, *volumePolicy, reason, volumeRecommendation, aggregator)
+					updatedRecommendation, err := runner.resizePVC(parentCtx, logger, pvca, pvc, *volumePolicy, false, reason, volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(buf.String()).To(ContainSubstring(expectedLogSubstring))
 
@@ -1601,6 +2075,56 @@ var _ = Describe("Periodic Runner", func() {
 				),
 			)
 
+			It("should not resize when the ResourceQuota of the namespace is exhausted", func() {
+				quota := &corev1.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Name: "storage-quota", Namespace: "default"},
+					Spec: corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("1536Mi")},
+					},
+				}
+				Expect(k8sClient.Create(parentCtx, quota)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(testutils.CleanupObject(parentCtx, k8sClient, quota)).To(Succeed())
+				})
+
+				quotaPatch := client.MergeFrom(quota.DeepCopy())
+				quota.Status.Hard = quota.Spec.Hard
+				quota.Status.Used = corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("1Gi")}
+				Expect(k8sClient.Status().Patch(parentCtx, quota, quotaPatch)).To(Succeed())
+				Eventually(func(g Gomega) {
+					var cached corev1.ResourceQuota
+					g.Expect(mgrClient.Get(parentCtx, client.ObjectKeyFromObject(quota), &cached)).To(Succeed())
+					g.Expect(cached.Status.Used).NotTo(BeEmpty())
+				}).Should(Succeed())
+
+				volumeRecommendation := v1alpha1.VolumeRecommendation{
+					Name:    pvc.Name,
+					Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
+				}
+
+				var buf strings.Builder
+				logger := zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &buf)))
+
+				runner.budgets.reset()
+				aggregator := &resizingConditionAggregator{}
+				volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
+				Expect(errPolicy).NotTo(HaveOccurred())
+				_, err := runner.resizePVC(parentCtx, logger, pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buf.String()).To(ContainSubstring("storage quota exceeded"))
+
+				var updatedPvc corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &updatedPvc)).To(Succeed())
+				Expect(updatedPvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+
+				Expect(aggregator.getAggregatedCondition()).To(And(
+					HaveField("Type", string(v1alpha1.ConditionTypeResizing)),
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Reason", ReasonQuotaExceeded),
+					HaveField("Message", Equal("test-pvc: resizing to 2Gi would exceed the ResourceQuota of namespace default")),
+				))
+			})
+
 			It("should not resize if max capacity has been reached", func() {
 				volumeRecommendation := v1alpha1.VolumeRecommendation{
 					Name: pvc.Name,
@@ -1615,9 +2139,9 @@ var _ = Describe("Periodic Runner", func() {
 
 				By("Performing first resize")
 				aggregator := &resizingConditionAggregator{}
-				volumePolicy, errPolicy := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(errPolicy).NotTo(HaveOccurred())
-				volumeRecommendation, err := runner.resizePVC(parentCtx, logger, pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+				volumeRecommendation, err := runner.resizePVC(parentCtx, logger, pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 				Expect(err).NotTo(HaveOccurred())
 
 				wantLog := `"resizing persistent volume claim","pvc":"test-pvc","from":"1Gi","to":"2Gi"}`
@@ -1635,10 +2159,12 @@ var _ = Describe("Periodic Runner", func() {
 				Expect(k8sClient.Status().Patch(parentCtx, &resizedPvc, patch)).To(Succeed())
 
 				By("Performing second resize")
+				// Simulate the cooldown period having elapsed since the first resize
+				volumeRecommendation.LastResizeTime = nil
 				aggregator = &resizingConditionAggregator{}
-				volumePolicy, errPolicy = getVolumePolicy(resizedPvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, errPolicy = getVolumePolicy(&resizedPvc, "", pvca.Spec.VolumePolicies)
 				Expect(errPolicy).NotTo(HaveOccurred())
-				volumeRecommendation, err = runner.resizePVC(parentCtx, logger, &resizedPvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+				volumeRecommendation, err = runner.resizePVC(parentCtx, logger, pvca, &resizedPvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 				Expect(err).NotTo(HaveOccurred())
 
 				wantLog = `"resizing persistent volume claim","pvc":"test-pvc","from":"2Gi","to":"3Gi"}`
@@ -1655,10 +2181,11 @@ var _ = Describe("Periodic Runner", func() {
 				Expect(k8sClient.Status().Patch(parentCtx, &resizedPvc, patch)).To(Succeed())
 
 				By("Expecting third attempt to fail with max capacity reached (already at max)")
+				volumeRecommendation.LastResizeTime = nil
 				aggregator = &resizingConditionAggregator{}
-				volumePolicy, errPolicy = getVolumePolicy(resizedPvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, errPolicy = getVolumePolicy(&resizedPvc, "", pvca.Spec.VolumePolicies)
 				Expect(errPolicy).NotTo(HaveOccurred())
-				_, err = runner.resizePVC(parentCtx, logger, &resizedPvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+				_, err = runner.resizePVC(parentCtx, logger, pvca, &resizedPvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(buf.String()).To(ContainSubstring("max capacity reached"))
 
@@ -1670,6 +2197,37 @@ var _ = Describe("Periodic Runner", func() {
 				))
 			})
 
+			It("should report the max monthly cost if the max capacity limited by it has been reached", func() {
+				volumeRecommendation := v1alpha1.VolumeRecommendation{
+					Name:    pvc.Name,
+					Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
+				}
+
+				var buf strings.Builder
+				logger := zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &buf)))
+
+				aggregator := &resizingConditionAggregator{}
+				volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
+				Expect(errPolicy).NotTo(HaveOccurred())
+				resizePolicy := *volumePolicy
+				resizePolicy.MaxMonthlyCost = ptr.To(resource.MustParse("1"))
+				resizePolicy.MaxCapacity = resource.MustParse("1Gi")
+				_, err := runner.resizePVC(parentCtx, logger, pvca, pvc, resizePolicy, true, "passing storage threshold", volumeRecommendation, aggregator)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buf.String()).To(ContainSubstring("max monthly cost reached"))
+				Expect(buf.String()).NotTo(ContainSubstring("max capacity reached"))
+
+				var updatedPvc corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &updatedPvc)).To(Succeed())
+				Expect(updatedPvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+
+				Expect(aggregator.getAggregatedCondition()).To(And(
+					HaveField("Type", string(v1alpha1.ConditionTypeResizing)),
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Reason", ReasonMaxMonthlyCostReached),
+				))
+			})
+
 			DescribeTable("clamp resize to max capacity",
 				func(maxCapacity resource.Quantity, minStep resource.Quantity, expectResize bool, expectedSize resource.Quantity) {
 					volumeRecommendation := v1alpha1.VolumeRecommendation{
@@ -1688,9 +2246,9 @@ var _ = Describe("Periodic Runner", func() {
 					Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
 
 					aggregator := &resizingConditionAggregator{}
-					volumePolicy, errPolicy := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+					volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 					Expect(errPolicy).NotTo(HaveOccurred())
-					_, err := runner.resizePVC(parentCtx, logger, pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+					_, err := runner.resizePVC(parentCtx, logger, pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 
 					var updatedPvc corev1.PersistentVolumeClaim
@@ -1738,9 +2296,9 @@ var _ = Describe("Periodic Runner", func() {
 
 					beforeResize := time.Now()
 					aggregator := &resizingConditionAggregator{}
-					volumePolicy, errPolicy := getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+					volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 					Expect(errPolicy).NotTo(HaveOccurred())
-					updatedRecommendation, err := runner.resizePVC(parentCtx, logger, pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+					updatedRecommendation, err := runner.resizePVC(parentCtx, logger, pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(buf.String()).To(ContainSubstring(expectedLog))
 
@@ -1766,6 +2324,138 @@ var _ = Describe("Periodic Runner", func() {
 					"resizing persistent volume claim",
 				),
 			)
+
+			DescribeTable("should bypass cooldown duration when critical utilization threshold is reached",
+				func(usedSpacePercent int, expectResize bool, expectedLog string) {
+					lastResizeTime := metav1.Now()
+					volumeRecommendation := v1alpha1.VolumeRecommendation{
+						Name: pvc.Name,
+						Current: v1alpha1.CurrentVolumeStatus{
+							UsedSpacePercent: ptr.To(usedSpacePercent),
+						},
+						LastResizeTime: &lastResizeTime,
+					}
+
+					pvcaPatch := client.MergeFrom(pvca.DeepCopy())
+					pvca.Spec.VolumePolicies[0].ScaleUp.CooldownDuration = &metav1.Duration{Duration: time.Hour}
+					pvca.Spec.VolumePolicies[0].ScaleUp.CriticalUtilizationPercent = ptr.To(95)
+					Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
+					waitForPVCACacheSync(parentCtx, pvca)
+
+					var buf strings.Builder
+					logger := zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &buf)))
+					recorder := record.NewFakeRecorder(128)
+					WithEventRecorder(recorder)(runner)
+
+					aggregator := &resizingConditionAggregator{}
+					volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
+					Expect(errPolicy).NotTo(HaveOccurred())
+					_, err := runner.resizePVC(parentCtx, logger, pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(buf.String()).To(ContainSubstring(expectedLog))
+
+					var pvcObj corev1.PersistentVolumeClaim
+					Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+					if expectResize {
+						Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("2Gi")))
+
+						event := <-recorder.Events
+						wantEvent := `Warning UsedSpaceCriticalThresholdReached used space (99%) exceeds the configured critical threshold (95%), bypassing cooldown`
+						Expect(event).To(Equal(wantEvent))
+					} else {
+						Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+						Expect(aggregator.getAggregatedCondition().Reason).To(Equal(ReasonPVCResizeCooldown))
+					}
+				},
+				Entry("should not resize when critical threshold is not reached",
+					90,
+					false,
+					"cooldown period not elapsed",
+				),
+				Entry("should resize when critical threshold is reached",
+					99,
+					true,
+					"resizing persistent volume claim",
+				),
+			)
+
+			It("should keep blocking non-critical resizes during the cooldown after a critical resize", func() {
+				lastResizeTime := metav1.Now()
+				volumeRecommendation := v1alpha1.VolumeRecommendation{
+					Name: pvc.Name,
+					Current: v1alpha1.CurrentVolumeStatus{
+						UsedSpacePercent: ptr.To(99),
+					},
+					LastResizeTime: &lastResizeTime,
+				}
+
+				pvcaPatch := client.MergeFrom(pvca.DeepCopy())
+				pvca.Spec.VolumePolicies[0].ScaleUp.CooldownDuration = &metav1.Duration{Duration: time.Hour}
+				pvca.Spec.VolumePolicies[0].ScaleUp.CriticalUtilizationPercent = ptr.To(95)
+				Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
+				waitForPVCACacheSync(parentCtx, pvca)
+
+				By("Resizing the PVC once the critical threshold is reached")
+				aggregator := &resizingConditionAggregator{}
+				volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
+				Expect(errPolicy).NotTo(HaveOccurred())
+				volumeRecommendation, err := runner.resizePVC(parentCtx, logr.Discard(), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+				Expect(err).NotTo(HaveOccurred())
+
+				var resizedPvc corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &resizedPvc)).To(Succeed())
+				Expect(resizedPvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("2Gi")))
+
+				By("Simulating the actual resize")
+				patch := client.MergeFrom(resizedPvc.DeepCopy())
+				resizedPvc.Status.Capacity[corev1.ResourceStorage] = resource.MustParse("2Gi")
+				Expect(k8sClient.Status().Patch(parentCtx, &resizedPvc, patch)).To(Succeed())
+
+				By("Blocking a non-critical resize with the same policy")
+				volumeRecommendation.Current.UsedSpacePercent = ptr.To(90)
+				aggregator = &resizingConditionAggregator{}
+				_, err = runner.resizePVC(parentCtx, logr.Discard(), pvca, &resizedPvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(aggregator.getAggregatedCondition().Reason).To(Equal(ReasonPVCResizeCooldown))
+
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &resizedPvc)).To(Succeed())
+				Expect(resizedPvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("2Gi")))
+			})
+
+			It("should not evaluate the critical utilization threshold when the cooldown has elapsed", func() {
+				lastResizeTime := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+				volumeRecommendation := v1alpha1.VolumeRecommendation{
+					Name: pvc.Name,
+					Current: v1alpha1.CurrentVolumeStatus{
+						UsedSpacePercent: ptr.To(99),
+					},
+					LastResizeTime: &lastResizeTime,
+				}
+
+				pvcaPatch := client.MergeFrom(pvca.DeepCopy())
+				pvca.Spec.VolumePolicies[0].ScaleUp.CooldownDuration = &metav1.Duration{Duration: time.Hour}
+				pvca.Spec.VolumePolicies[0].ScaleUp.CriticalUtilizationPercent = ptr.To(95)
+				Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
+				waitForPVCACacheSync(parentCtx, pvca)
+
+				recorder := record.NewFakeRecorder(128)
+				WithEventRecorder(recorder)(runner)
+
+				aggregator := &resizingConditionAggregator{}
+				volumePolicy, _, errPolicy := getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
+				Expect(errPolicy).NotTo(HaveOccurred())
+				_, err := runner.resizePVC(parentCtx, logr.Discard(), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+				Expect(err).NotTo(HaveOccurred())
+
+				var pvcObj corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+				Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("2Gi")))
+
+				close(recorder.Events)
+				for event := range recorder.Events {
+					Expect(event).NotTo(ContainSubstring("CriticalThresholdReached"))
+				}
+			})
 		})
 
 		Describe("resize strategies", func() {
@@ -1788,7 +2478,7 @@ var _ = Describe("Periodic Runner", func() {
 				waitForPVCACacheSync(parentCtx, pvca)
 
 				var err error
-				volumePolicy, err = getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+				volumePolicy, _, err = getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -1802,7 +2492,7 @@ var _ = Describe("Periodic Runner", func() {
 						Name:    pvc.Name,
 						Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
 					}
-					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(logOutput.String()).To(ContainSubstring("resizing persistent volume claim"))
@@ -1816,6 +2506,23 @@ var _ = Describe("Periodic Runner", func() {
 					))
 				})
 
+				It("should record the resize in the resize history", func() {
+					volumeRecommendation := v1alpha1.VolumeRecommendation{
+						Name:    pvc.Name,
+						Current: v1alpha1.CurrentVolumeStatus{UsedInodesPercent: ptr.To(95)},
+					}
+					updatedRecommendation, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing inodes threshold", volumeRecommendation, aggregator)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(updatedRecommendation.ResizeHistory).To(ConsistOf(And(
+						HaveField("From", resource.MustParse("1Gi")),
+						HaveField("To", resource.MustParse("2Gi")),
+						HaveField("Trigger", v1alpha1.ResizeTriggerInodes),
+						HaveField("UsedInodesPercent", Equal(ptr.To(95))),
+						HaveField("Outcome", v1alpha1.ResizeOutcomeInProgress),
+					)))
+				})
+
 				It("should set Resizing=False condition when max capacity is reached", func() {
 					pvcaPatch := client.MergeFrom(pvca.DeepCopy())
 					pvca.Spec.VolumePolicies[0].MaxCapacity = resource.MustParse("1500Mi")
@@ -1826,8 +2533,8 @@ var _ = Describe("Periodic Runner", func() {
 						Name:    pvc.Name,
 						Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
 					}
-					volumePolicy, _ = getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
-					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+					volumePolicy, _, _ = getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
+					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(logOutput.String()).To(ContainSubstring("max capacity reached"))
@@ -1849,7 +2556,7 @@ var _ = Describe("Periodic Runner", func() {
 						Name:    pvc.Name,
 						Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
 					}
-					updatedRecommendation, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+					updatedRecommendation, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 
 					var pvcObj corev1.PersistentVolumeClaim
@@ -1865,19 +2572,191 @@ var _ = Describe("Periodic Runner", func() {
 					pvca.Spec.VolumePolicies[0].MaxCapacity = resource.MustParse("1500Mi")
 					Expect(k8sClient.Patch(parentCtx, pvca, pvcaPatch)).To(Succeed())
 					waitForPVCACacheSync(parentCtx, pvca)
-					volumePolicy, _ = getVolumePolicy(pvc.Name, pvca.Spec.VolumePolicies)
+					volumePolicy, _, _ = getVolumePolicy(pvc, "", pvca.Spec.VolumePolicies)
 
 					volumeRecommendation := v1alpha1.VolumeRecommendation{
 						Name:    pvc.Name,
 						Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
 					}
-					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvc, *volumePolicy, "passing storage threshold", volumeRecommendation, aggregator)
+					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(logOutput.String()).To(ContainSubstring("max capacity reached"))
 					Expect(aggregator.getAggregatedCondition().Message).To(BeEmpty())
 				})
 			})
+
+			When("using Manual strategy", func() {
+				BeforeEach(func() {
+					strategy = v1alpha1.ManualVolumeResizeStrategy
+				})
+
+				It("should wait for approval without patching the PVC", func() {
+					volumeRecommendation := v1alpha1.VolumeRecommendation{
+						Name:    pvc.Name,
+						Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
+					}
+					updatedRecommendation, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+					Expect(err).NotTo(HaveOccurred())
+
+					var pvcObj corev1.PersistentVolumeClaim
+					Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+					Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+					Expect(updatedRecommendation.Target.Size).To(Equal(ptr.To(resource.MustParse("2Gi"))))
+					Expect(aggregator.getAggregatedCondition()).To(And(
+						HaveField("Status", metav1.ConditionFalse),
+						HaveField("Reason", ReasonApprovalPending),
+						HaveField("Message", ContainSubstring("waiting for approval of resize to 2Gi")),
+					))
+				})
+
+				It("should patch the PVC once the recommended size has been approved on the PVCA", func() {
+					metav1.SetMetaDataAnnotation(&pvca.ObjectMeta, common.AnnotationApprovedSize, "2Gi")
+					metav1.SetMetaDataAnnotation(&pvca.ObjectMeta, common.AnnotationApprovedAt, time.Now().Format(time.RFC3339))
+
+					volumeRecommendation := v1alpha1.VolumeRecommendation{
+						Name:    pvc.Name,
+						Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(95)},
+					}
+					_, err := runner.resizePVC(parentCtx, zap.New(zap.WriteTo(io.MultiWriter(GinkgoWriter, &logOutput))), pvca, pvc, *volumePolicy, false, "passing storage threshold", volumeRecommendation, aggregator)
+					Expect(err).NotTo(HaveOccurred())
+
+					var pvcObj corev1.PersistentVolumeClaim
+					Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), &pvcObj)).To(Succeed())
+					Expect(pvcObj.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("2Gi")))
+					Expect(aggregator.getAggregatedCondition()).To(HaveField("Status", metav1.ConditionTrue))
+				})
+			})
+		})
+
+		Describe("#scaleUniformly", func() {
+			var (
+				pvcA   *corev1.PersistentVolumeClaim
+				pvcB   *corev1.PersistentVolumeClaim
+				policy v1alpha1.VolumePolicy
+			)
+
+			BeforeEach(func() {
+				pvcA = createPVC(parentCtx, "uniform-pvc-a", ptr.To(testutils.StorageClassName), nil)
+				pvcB = createPVC(parentCtx, "uniform-pvc-b", ptr.To(testutils.StorageClassName), nil)
+				for _, p := range []*corev1.PersistentVolumeClaim{pvcA, pvcB} {
+					DeferCleanup(func() {
+						Expect(testutils.CleanupObject(parentCtx, k8sClient, p)).To(Succeed())
+						Eventually(func() error {
+							return k8sClient.Get(parentCtx, client.ObjectKeyFromObject(p), p)
+						}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+					})
+				}
+
+				policy = v1alpha1.VolumePolicy{
+					MaxCapacity:    resource.MustParse("3Gi"),
+					UniformScaling: true,
+					ScaleUp: &v1alpha1.ScalingRules{
+						ResizeStrategy: v1alpha1.InPlaceVolumeResizeStrategy,
+					},
+				}
+			})
+
+			// newRecommendations simulates pvcA having just been resized to targetA
+			newRecommendations := func(targetA string) []v1alpha1.VolumeRecommendation {
+				pvcA.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse(targetA)
+
+				return []v1alpha1.VolumeRecommendation{
+					{Name: pvcA.Name, Target: v1alpha1.TargetRecommendation{Size: ptr.To(resource.MustParse(targetA))}},
+					{Name: pvcB.Name},
+				}
+			}
+
+			It("should resize the remaining PVCs to the largest recommended size", func() {
+				recommendations := newRecommendations("2Gi")
+				aggregator := &resizingConditionAggregator{}
+				members := []uniformScalingMember{{pvc: pvcA}, {pvc: pvcB}}
+
+				runner.scaleUniformly(parentCtx, logr.Discard(), pvca, policy, 0, members, &recommendations, aggregator)
+
+				var updatedPVC corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcB), &updatedPVC)).To(Succeed())
+				Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("2Gi")))
+				Expect(updatedPVC.Annotations).To(HaveKeyWithValue(common.AnnotationPreviousSize, "1Gi"))
+
+				Expect(recommendations[1].Target.Size).To(Equal(ptr.To(resource.MustParse("2Gi"))))
+				Expect(recommendations[1].LastResizeTime).NotTo(BeNil())
+				Expect(aggregator.getAggregatedCondition()).To(And(
+					HaveField("Type", string(v1alpha1.ConditionTypeResizing)),
+					HaveField("Status", metav1.ConditionTrue),
+					HaveField("Reason", ReasonUniformScaling),
+					HaveField("Message", ContainSubstring("uniform-pvc-b: resizing to 2Gi to match the largest PersistentVolumeClaim of volume policy 0")),
+				))
+			})
+
+			It("should clamp the aligned size to the max capacity", func() {
+				recommendations := newRecommendations("5Gi")
+				aggregator := &resizingConditionAggregator{}
+				members := []uniformScalingMember{{pvc: pvcA}, {pvc: pvcB}}
+
+				runner.scaleUniformly(parentCtx, logr.Discard(), pvca, policy, 0, members, &recommendations, aggregator)
+
+				var updatedPVC corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcB), &updatedPVC)).To(Succeed())
+				Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("3Gi")))
+			})
+
+			It("should not resize PVCs with a resize in progress", func() {
+				recommendations := newRecommendations("2Gi")
+				aggregator := &resizingConditionAggregator{}
+				members := []uniformScalingMember{{pvc: pvcA}, {pvc: pvcB, inProgress: true}}
+
+				runner.scaleUniformly(parentCtx, logr.Discard(), pvca, policy, 0, members, &recommendations, aggregator)
+
+				var updatedPVC corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcB), &updatedPVC)).To(Succeed())
+				Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+				Expect(aggregator.getAggregatedCondition().Message).To(BeEmpty())
+			})
+
+			It("should only update the recommendation when the resize strategy is Off", func() {
+				policy.ScaleUp.ResizeStrategy = v1alpha1.OffVolumeResizeStrategy
+				recommendations := newRecommendations("2Gi")
+				aggregator := &resizingConditionAggregator{}
+				members := []uniformScalingMember{{pvc: pvcA}, {pvc: pvcB}}
+
+				runner.scaleUniformly(parentCtx, logr.Discard(), pvca, policy, 0, members, &recommendations, aggregator)
+
+				var updatedPVC corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcB), &updatedPVC)).To(Succeed())
+				Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+				Expect(recommendations[1].Target.Size).To(Equal(ptr.To(resource.MustParse("2Gi"))))
+				Expect(recommendations[1].LastResizeTime).To(BeNil())
+			})
+			It("should only update the recommendation of paused PVCs", func() {
+				recommendations := newRecommendations("2Gi")
+				aggregator := &resizingConditionAggregator{}
+				members := []uniformScalingMember{{pvc: pvcA}, {pvc: pvcB, paused: true}}
+
+				runner.scaleUniformly(parentCtx, logr.Discard(), pvca, policy, 0, members, &recommendations, aggregator)
+
+				var updatedPVC corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcB), &updatedPVC)).To(Succeed())
+				Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+				Expect(recommendations[1].Target.Size).To(Equal(ptr.To(resource.MustParse("2Gi"))))
+				Expect(recommendations[1].LastResizeTime).To(BeNil())
+				Expect(aggregator.getAggregatedCondition().Message).To(BeEmpty())
+			})
+
+			It("should wait for approval of the largest size when the resize strategy is Manual", func() {
+				policy.ScaleUp.ResizeStrategy = v1alpha1.ManualVolumeResizeStrategy
+				recommendations := newRecommendations("2Gi")
+				aggregator := &resizingConditionAggregator{}
+				members := []uniformScalingMember{{pvc: pvcA}, {pvc: pvcB}}
+
+				runner.scaleUniformly(parentCtx, logr.Discard(), pvca, policy, 0, members, &recommendations, aggregator)
+
+				var updatedPVC corev1.PersistentVolumeClaim
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcB), &updatedPVC)).To(Succeed())
+				Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+				Expect(recommendations[1].Target.Size).To(Equal(ptr.To(resource.MustParse("2Gi"))))
+				Expect(aggregator.getAggregatedCondition()).To(HaveField("Reason", ReasonApprovalPending))
+			})
 		})
 
 		Describe("#SetStatus", func() {
@@ -1897,7 +2776,7 @@ var _ = Describe("Periodic Runner", func() {
 				})
 
 				emptyRes := metav1.Condition{Type: string(v1alpha1.ConditionTypeResizing)}
-				Expect(runner.setStatus(parentCtx, pvca, recAgg.getAggregatedCondition(), emptyRes, nil)).To(Succeed())
+				Expect(runner.setStatus(parentCtx, pvca, []metav1.Condition{emptyRes, recAgg.getAggregatedCondition()}, nil, statusSummary{})).To(Succeed())
 
 				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
 				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
@@ -1919,7 +2798,7 @@ var _ = Describe("Periodic Runner", func() {
 				})
 
 				emptyRec := metav1.Condition{Type: string(v1alpha1.ConditionTypeRecommendationAvailable)}
-				Expect(runner.setStatus(parentCtx, pvca, emptyRec, resAgg.getAggregatedCondition(), nil)).To(Succeed())
+				Expect(runner.setStatus(parentCtx, pvca, []metav1.Condition{resAgg.getAggregatedCondition(), emptyRec}, nil, statusSummary{})).To(Succeed())
 
 				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
 				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
@@ -1947,7 +2826,7 @@ var _ = Describe("Periodic Runner", func() {
 
 				emptyRec := metav1.Condition{Type: string(v1alpha1.ConditionTypeRecommendationAvailable)}
 				emptyRes := metav1.Condition{Type: string(v1alpha1.ConditionTypeResizing)}
-				Expect(runner.setStatus(parentCtx, pvca, emptyRec, emptyRes, nil)).To(Succeed())
+				Expect(runner.setStatus(parentCtx, pvca, []metav1.Condition{emptyRes, emptyRec}, nil, statusSummary{})).To(Succeed())
 
 				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
 				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
@@ -1965,7 +2844,7 @@ var _ = Describe("Periodic Runner", func() {
 
 				emptyRec := metav1.Condition{Type: string(v1alpha1.ConditionTypeRecommendationAvailable)}
 				emptyRes := metav1.Condition{Type: string(v1alpha1.ConditionTypeResizing)}
-				Expect(runner.setStatus(parentCtx, pvca, emptyRec, emptyRes, recommendations)).To(Succeed())
+				Expect(runner.setStatus(parentCtx, pvca, []metav1.Condition{emptyRes, emptyRec}, recommendations, statusSummary{})).To(Succeed())
 
 				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
 				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
@@ -1975,6 +2854,34 @@ var _ = Describe("Periodic Runner", func() {
 				}
 				Expect(names).To(Equal([]string{"pvc-a", "pvc-b", "pvc-c"}))
 			})
+
+			It("should persist the observed generation and the summary", func() {
+				recAgg := &recommendationsConditionAggregator{}
+				emptyRes := metav1.Condition{Type: string(v1alpha1.ConditionTypeResizing)}
+				summary := statusSummary{
+					reconcileTime:         ptr.To(metav1.Now()),
+					managedPVCs:           2,
+					healthyPVCs:           1,
+					resizingPVCs:          1,
+					maxUtilizationPercent: ptr.To(85),
+				}
+				Expect(runner.setStatus(parentCtx, pvca, []metav1.Condition{emptyRes, recAgg.getAggregatedCondition()}, nil, summary)).To(Succeed())
+
+				updatedPVCA := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+				Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), updatedPVCA)).To(Succeed())
+				Expect(updatedPVCA.Status).To(And(
+					HaveField("ObservedGeneration", updatedPVCA.Generation),
+					HaveField("LastReconcileTime", Not(BeNil())),
+					HaveField("ManagedPVCs", 2),
+					HaveField("HealthyPVCs", 1),
+					HaveField("ResizingPVCs", 1),
+					HaveField("MaxUtilizationPercent", Equal(ptr.To(85))),
+				))
+				Expect(updatedPVCA.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", string(v1alpha1.ConditionTypeRecommendationAvailable)),
+					HaveField("ObservedGeneration", updatedPVCA.Generation),
+				)))
+			})
 		})
 	})
 
@@ -2068,4 +2975,202 @@ var _ = Describe("Periodic Runner", func() {
 			Entry("default runner reconciles only PVCAs with empty autoscalerName", "", "", "bar"),
 		)
 	})
+
+	Context("check scheduling", func() {
+		It("should only reconcile the PVCAs whose check is due", func() {
+			By("Creating PVCs for each PVCA")
+			pvcDue := createPVC(parentCtx, "schedule-pvc-due", ptr.To(testutils.StorageClassName), nil)
+			pvcNotDue := createPVC(parentCtx, "schedule-pvc-not-due", ptr.To(testutils.StorageClassName), nil)
+			for _, pvc := range []*corev1.PersistentVolumeClaim{pvcDue, pvcNotDue} {
+				DeferCleanup(func() {
+					Expect(testutils.CleanupObject(parentCtx, k8sClient, pvc)).To(Succeed())
+					Eventually(func() error {
+						return k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvc), pvc)
+					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+				})
+			}
+
+			By("Creating the PVCAs")
+			pvcaDue := createPVCA(parentCtx, "schedule-pvca-due", "", autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "PersistentVolumeClaim",
+				Name:       pvcDue.Name,
+			}, []v1alpha1.VolumePolicy{{MaxCapacity: resource.MustParse("10Gi")}})
+			pvcaNotDue := createPVCA(parentCtx, "schedule-pvca-not-due", "", autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "PersistentVolumeClaim",
+				Name:       pvcNotDue.Name,
+			}, []v1alpha1.VolumePolicy{{MaxCapacity: resource.MustParse("10Gi")}})
+			for _, pvca := range []*v1alpha1.PersistentVolumeClaimAutoscaler{pvcaDue, pvcaNotDue} {
+				DeferCleanup(func() {
+					Expect(testutils.CleanupObject(parentCtx, k8sClient, pvca)).To(Succeed())
+					Eventually(func() error {
+						return k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvca), pvca)
+					}).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+				})
+			}
+
+			By("Configuring the check interval of the due PVCA")
+			patch := client.MergeFrom(pvcaDue.DeepCopy())
+			pvcaDue.Spec.CheckInterval = &metav1.Duration{Duration: 30 * time.Second}
+			Expect(k8sClient.Patch(parentCtx, pvcaDue, patch)).To(Succeed())
+			waitForPVCACacheSync(parentCtx, pvcaDue)
+
+			By("Scheduling the check of the other PVCA in the future")
+			runner, err := newRunner()
+			Expect(err).NotTo(HaveOccurred())
+			registerPVCMetrics(runner, pvcDue, pvcNotDue)
+			runner.schedule.set(client.ObjectKeyFromObject(pvcaNotDue), time.Now().Add(time.Hour))
+
+			Expect(runner.reconcileDue(parentCtx)).To(Succeed())
+
+			By("Verifying the due PVCA has been reconciled and scheduled after its check interval")
+			updatedDue := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+			Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcaDue), updatedDue)).To(Succeed())
+			Expect(updatedDue.Status.Conditions).NotTo(BeEmpty())
+			Expect(updatedDue.Status.NextCheckTime).NotTo(BeNil())
+			Expect(updatedDue.Status.NextCheckTime.Time).To(BeTemporally("~", time.Now().Add(30*time.Second), 5*time.Second))
+
+			By("Verifying the PVCA which is not due has not been reconciled")
+			updatedNotDue := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+			Expect(k8sClient.Get(parentCtx, client.ObjectKeyFromObject(pvcaNotDue), updatedNotDue)).To(Succeed())
+			Expect(updatedNotDue.Status.Conditions).To(BeEmpty())
+			Expect(updatedNotDue.Status.NextCheckTime).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("resolvePVCOwnership", func() {
+	It("should assign each PVC to exactly one autoscaler", func() {
+		var (
+			older = metav1.NewTime(time.Now().Add(-time.Hour))
+			newer = metav1.Now()
+
+			pvcA = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-a", Namespace: "default"}}
+			pvcB = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-b", Namespace: "default"}}
+			pvcC = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-c", Namespace: "default"}}
+
+			oldCPVCA = &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "old", CreationTimestamp: older}}
+			newPVCA  = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default", CreationTimestamp: newer}}
+			oldPVCA  = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default", CreationTimestamp: older}}
+		)
+
+		pvcaToPVCsMap := map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim{
+			oldCPVCA: {pvcA, pvcB, pvcC},
+			newPVCA:  {pvcA, pvcB},
+			oldPVCA:  {pvcA},
+		}
+
+		overlapping := resolvePVCOwnership(logr.Discard(), pvcaToPVCsMap)
+
+		Expect(pvcaToPVCsMap[oldPVCA]).To(ConsistOf(pvcA))
+		Expect(pvcaToPVCsMap[newPVCA]).To(ConsistOf(pvcB))
+		Expect(pvcaToPVCsMap[oldCPVCA]).To(ConsistOf(pvcC))
+
+		Expect(overlapping).NotTo(HaveKey(oldPVCA))
+		Expect(overlapping[newPVCA]).To(ConsistOf("pvc-a (PersistentVolumeClaimAutoscaler/old)"))
+		Expect(overlapping[oldCPVCA]).To(ConsistOf(
+			"default/pvc-a (PersistentVolumeClaimAutoscaler/old)",
+			"default/pvc-b (PersistentVolumeClaimAutoscaler/new)",
+		))
+	})
+
+	It("should break ties by name", func() {
+		var (
+			creationTimestamp = metav1.Now()
+
+			pvc = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "default"}}
+
+			pvcaA = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default", CreationTimestamp: creationTimestamp}}
+			pvcaB = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default", CreationTimestamp: creationTimestamp}}
+		)
+
+		pvcaToPVCsMap := map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim{
+			pvcaB: {pvc},
+			pvcaA: {pvc},
+		}
+
+		resolvePVCOwnership(logr.Discard(), pvcaToPVCsMap)
+
+		Expect(pvcaToPVCsMap[pvcaA]).To(ConsistOf(pvc))
+		Expect(pvcaToPVCsMap[pvcaB]).To(BeEmpty())
+	})
+})
+
+var _ = Describe("#listOtherAutoscalers", func() {
+	It("should return the autoscalers of other autoscaler names", func() {
+		scheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		var (
+			own        = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "own", Namespace: "default"}, Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{AutoscalerName: "instance-a"}}
+			otherPVCA  = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}, Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{AutoscalerName: "instance-b"}}
+			otherCPVCA = &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+		)
+
+		r := &Runner{
+			client:         fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(own, otherPVCA, otherCPVCA).Build(),
+			autoscalerName: "instance-a",
+		}
+
+		others, err := r.listOtherAutoscalers(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(others).To(ConsistOf(
+			BeAssignableToTypeOf(&v1alpha1.PersistentVolumeClaimAutoscaler{}),
+			BeAssignableToTypeOf(&v1alpha1.ClusterPersistentVolumeClaimAutoscaler{}),
+		))
+		Expect(others).To(HaveEach(HaveField("ObjectMeta.Name", "other")))
+	})
+})
+
+var _ = Describe("uniformScalingGroups", func() {
+	var (
+		pvcA = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-a", Namespace: "team-a"}}
+		pvcB = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-b", Namespace: "team-a"}}
+		pvcC = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-c", Namespace: "team-b"}}
+		pvcD = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-d", Namespace: "team-a"}}
+
+		noTarget = autoscalingv1.CrossVersionObjectReference{}
+	)
+
+	It("should group the PVCs by volume policy and namespace", func() {
+		groups := make(uniformScalingGroups)
+		groups.add(1, noTarget, uniformScalingMember{pvc: pvcD})
+		groups.add(0, noTarget, uniformScalingMember{pvc: pvcC})
+		groups.add(0, noTarget, uniformScalingMember{pvc: pvcA})
+		groups.add(0, noTarget, uniformScalingMember{pvc: pvcB})
+
+		Expect(groups.keys()).To(Equal([]uniformScalingGroupKey{
+			{policyIndex: 0, namespace: "team-a"},
+			{policyIndex: 0, namespace: "team-b"},
+			{policyIndex: 1, namespace: "team-a"},
+		}))
+		Expect(groups[uniformScalingGroupKey{policyIndex: 0, namespace: "team-a"}]).To(ConsistOf(
+			uniformScalingMember{pvc: pvcA},
+			uniformScalingMember{pvc: pvcB},
+		))
+		Expect(groups[uniformScalingGroupKey{policyIndex: 0, namespace: "team-b"}]).To(ConsistOf(uniformScalingMember{pvc: pvcC}))
+	})
+
+	It("should group the PVCs of multiple targets by target", func() {
+		var (
+			postgres = autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "postgres"}
+			redis    = autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "redis"}
+		)
+
+		groups := make(uniformScalingGroups)
+		groups.add(0, redis, uniformScalingMember{pvc: pvcD})
+		groups.add(0, postgres, uniformScalingMember{pvc: pvcA})
+		groups.add(0, postgres, uniformScalingMember{pvc: pvcB})
+
+		Expect(groups.keys()).To(Equal([]uniformScalingGroupKey{
+			{policyIndex: 0, namespace: "team-a", target: postgres},
+			{policyIndex: 0, namespace: "team-a", target: redis},
+		}))
+		Expect(groups[uniformScalingGroupKey{policyIndex: 0, namespace: "team-a", target: postgres}]).To(ConsistOf(
+			uniformScalingMember{pvc: pvcA},
+			uniformScalingMember{pvc: pvcB},
+		))
+		Expect(groups[uniformScalingGroupKey{policyIndex: 0, namespace: "team-a", target: redis}]).To(ConsistOf(uniformScalingMember{pvc: pvcD}))
+	})
 })
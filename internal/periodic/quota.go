@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+	metricssource "github.com/gardener/pvc-autoscaler/internal/metrics/source"
+)
+
+// storageClassRequestsStorageSuffix is the suffix of the ResourceQuota
+// resource name, which limits the requested storage of a single storage class.
+const storageClassRequestsStorageSuffix = ".storageclass.storage.k8s.io/requests.storage"
+
+// budgetTracker keeps track of the remaining storage headroom of namespaces
+// and storage classes within a single reconciliation cycle. The headroom is
+// derived from the ResourceQuota objects of a namespace and from the storage
+// budgets configured via the [common.AnnotationStorageBudget] annotation on
+// Namespace and StorageClass objects.
+type budgetTracker struct {
+	client client.Client
+
+	// headrooms maps a budget to its remaining headroom. A nil headroom
+	// means that the budget is not limited.
+	headrooms map[budgetKey]*resource.Quantity
+}
+
+// budgetKind is the kind of a storage budget.
+type budgetKind string
+
+const (
+	budgetKindNamespaceQuota    budgetKind = "ResourceQuota of namespace"
+	budgetKindStorageClassQuota budgetKind = "ResourceQuota for storage class"
+	budgetKindNamespace         budgetKind = "storage budget of namespace"
+	budgetKindStorageClass      budgetKind = "storage budget of storage class"
+)
+
+// budgetKey identifies a single storage budget.
+type budgetKey struct {
+	kind         budgetKind
+	namespace    string
+	storageClass string
+}
+
+// String implements the [fmt.Stringer] interface.
+func (k budgetKey) String() string {
+	switch k.kind {
+	case budgetKindNamespaceQuota, budgetKindNamespace:
+		return fmt.Sprintf("%s %s", k.kind, k.namespace)
+	case budgetKindStorageClassQuota:
+		return fmt.Sprintf("%s %s in namespace %s", k.kind, k.storageClass, k.namespace)
+	default:
+		return fmt.Sprintf("%s %s", k.kind, k.storageClass)
+	}
+}
+
+// newBudgetTracker creates a new [budgetTracker], which uses the given client
+// for looking up quotas and budgets.
+func newBudgetTracker(c client.Client) *budgetTracker {
+	return &budgetTracker{
+		client:    c,
+		headrooms: make(map[budgetKey]*resource.Quantity),
+	}
+}
+
+// reset forgets all previously computed headrooms, so that they are looked
+// up again.
+func (b *budgetTracker) reset() {
+	clear(b.headrooms)
+}
+
+// budgetKeysForPVC returns the keys of all budgets which apply to the given
+// [corev1.PersistentVolumeClaim].
+func budgetKeysForPVC(pvc *corev1.PersistentVolumeClaim) []budgetKey {
+	scName := ptr.Deref(pvc.Spec.StorageClassName, "")
+
+	return []budgetKey{
+		{kind: budgetKindNamespaceQuota, namespace: pvc.Namespace},
+		{kind: budgetKindStorageClassQuota, namespace: pvc.Namespace, storageClass: scName},
+		{kind: budgetKindNamespace, namespace: pvc.Namespace},
+		{kind: budgetKindStorageClass, storageClass: scName},
+	}
+}
+
+// capToBudget caps the target size of the given [corev1.PersistentVolumeClaim]
+// to the remaining headroom of all budgets applying to it. It returns the
+// allowed size together with the budget limiting the resize, if any. The
+// returned size is nil, when the headroom is less than the scaling resolution.
+func (b *budgetTracker) capToBudget(ctx context.Context, pvc *corev1.PersistentVolumeClaim, currSize, targetSize *resource.Quantity) (*resource.Quantity, *budgetKey, error) {
+	var (
+		increase = targetSize.Value() - currSize.Value()
+		limit    *budgetKey
+		allowed  = increase
+	)
+
+	for _, key := range budgetKeysForPVC(pvc) {
+		headroom, err := b.headroom(ctx, key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if headroom != nil && headroom.Value() < allowed {
+			allowed = headroom.Value()
+			limit = &key
+		}
+	}
+
+	if limit == nil {
+		return targetSize, nil, nil
+	}
+
+	// Keep the size divisible by the scaling resolution
+	allowed -= allowed % common.ScalingResolutionBytes
+	if allowed < common.ScalingResolutionBytes {
+		return nil, limit, nil
+	}
+
+	return resource.NewQuantity(currSize.Value()+allowed, resource.BinarySI), limit, nil
+}
+
+// consume subtracts the increase of the [corev1.PersistentVolumeClaim] from
+// the given size to the new size from the headroom of all budgets applying to
+// it.
+func (b *budgetTracker) consume(pvc *corev1.PersistentVolumeClaim, newSize, oldSize resource.Quantity) {
+	for _, key := range budgetKeysForPVC(pvc) {
+		if headroom := b.headrooms[key]; headroom != nil {
+			headroom.Sub(newSize)
+			headroom.Add(oldSize)
+		}
+	}
+}
+
+// headroom returns the remaining headroom of the given budget. It returns nil
+// if the budget is not limited.
+func (b *budgetTracker) headroom(ctx context.Context, key budgetKey) (*resource.Quantity, error) {
+	if headroom, ok := b.headrooms[key]; ok {
+		return headroom, nil
+	}
+
+	var (
+		headroom *resource.Quantity
+		err      error
+	)
+
+	switch key.kind {
+	case budgetKindNamespaceQuota:
+		headroom, err = b.quotaHeadroom(ctx, key.namespace, corev1.ResourceRequestsStorage)
+	case budgetKindStorageClassQuota:
+		if key.storageClass != "" {
+			headroom, err = b.quotaHeadroom(ctx, key.namespace, corev1.ResourceName(key.storageClass+storageClassRequestsStorageSuffix))
+		}
+	case budgetKindNamespace:
+		headroom, err = b.namespaceBudgetHeadroom(ctx, key.namespace)
+	case budgetKindStorageClass:
+		if key.storageClass != "" {
+			headroom, err = b.storageClassBudgetHeadroom(ctx, key.storageClass)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute headroom of %s: %w", key, err)
+	}
+
+	b.headrooms[key] = headroom
+
+	return headroom, nil
+}
+
+// quotaHeadroom returns the smallest remaining headroom for the given resource
+// across all ResourceQuota objects in the namespace. It returns nil if none of
+// the quotas limits the resource.
+func (b *budgetTracker) quotaHeadroom(ctx context.Context, namespace string, resourceName corev1.ResourceName) (*resource.Quantity, error) {
+	var quotaList corev1.ResourceQuotaList
+	if err := b.client.List(ctx, &quotaList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var headroom *resource.Quantity
+	for _, quota := range quotaList.Items {
+		hard, ok := quota.Status.Hard[resourceName]
+		if !ok {
+			continue
+		}
+
+		remaining := hard.DeepCopy()
+		remaining.Sub(quota.Status.Used[resourceName])
+		if headroom == nil || remaining.Cmp(*headroom) < 0 {
+			headroom = &remaining
+		}
+	}
+
+	return headroom, nil
+}
+
+// namespaceBudgetHeadroom returns the remaining headroom of the storage budget
+// configured on the Namespace. It returns nil if no budget is configured, or if
+// the Namespace cannot be looked up.
+func (b *budgetTracker) namespaceBudgetHeadroom(ctx context.Context, namespace string) (*resource.Quantity, error) {
+	var ns corev1.Namespace
+	if err := b.client.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		log.FromContext(ctx, "controller", common.ControllerName).Error(err, "failed to look up storage budget, assuming no budget", "namespace", namespace)
+
+		return nil, nil
+	}
+
+	budget, err := parseStorageBudget(ns.Annotations)
+	if err != nil || budget == nil {
+		return nil, err
+	}
+
+	var pvcList corev1.PersistentVolumeClaimList
+	if err := b.client.List(ctx, &pvcList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, pvc := range pvcList.Items {
+		budget.Sub(*pvc.Spec.Resources.Requests.Storage())
+	}
+
+	return budget, nil
+}
+
+// storageClassBudgetHeadroom returns the remaining headroom of the storage
+// budget configured on the StorageClass, which applies to all namespaces. It
+// returns nil if no budget is configured, or if the StorageClass cannot be
+// looked up.
+func (b *budgetTracker) storageClassBudgetHeadroom(ctx context.Context, storageClass string) (*resource.Quantity, error) {
+	var sc storagev1.StorageClass
+	if err := b.client.Get(ctx, types.NamespacedName{Name: storageClass}, &sc); err != nil {
+		log.FromContext(ctx, "controller", common.ControllerName).Error(err, "failed to look up storage budget, assuming no budget", "storageClass", storageClass)
+
+		return nil, nil
+	}
+
+	budget, err := parseStorageBudget(sc.Annotations)
+	if err != nil || budget == nil {
+		return nil, err
+	}
+
+	var pvcList corev1.PersistentVolumeClaimList
+	if err := b.client.List(ctx, &pvcList, client.MatchingFields{StorageClassIndexKey: storageClass}); err != nil {
+		return nil, err
+	}
+
+	for _, pvc := range pvcList.Items {
+		budget.Sub(*pvc.Spec.Resources.Requests.Storage())
+	}
+
+	return budget, nil
+}
+
+// parseStorageBudget parses the [common.AnnotationStorageBudget] annotation
+// from the given annotations. It returns nil if the annotation is not set.
+func parseStorageBudget(annotations map[string]string) (*resource.Quantity, error) {
+	value, ok := annotations[common.AnnotationStorageBudget]
+	if !ok {
+		return nil, nil
+	}
+
+	budget, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation %q: %w", common.AnnotationStorageBudget, value, err)
+	}
+
+	return &budget, nil
+}
+
+// urgency returns the highest utilization percentage (space or inodes) of the
+// given volume, or zero if the utilization is unknown.
+func urgency(volInfo *metricssource.VolumeInfo) int {
+	if volInfo == nil {
+		return 0
+	}
+
+	usedSpace, err := volInfo.UsedSpacePercentage()
+	if err != nil {
+		usedSpace = 0
+	}
+
+	usedInodes, err := volInfo.UsedInodesPercentage()
+	if err != nil {
+		usedInodes = 0
+	}
+
+	return max(usedSpace, usedInodes)
+}
+
+// sortPVCAsByUrgency sorts the [corev1.PersistentVolumeClaim] objects of each
+// [v1alpha1.Autoscaler] by decreasing urgency and returns
+// the PVCAs ordered by the urgency of their most utilized PVC, so that scarce
+// quotas and budgets are spent on the fullest volumes first. Ties are broken
+// by namespace and name, to keep the order stable.
+func sortPVCAsByUrgency(pvcaToPVCsMap map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim, metricsData metricssource.Metrics) []v1alpha1.Autoscaler {
+	pvcaUrgency := make(map[v1alpha1.Autoscaler]int, len(pvcaToPVCsMap))
+	for pvca, pvcs := range pvcaToPVCsMap {
+		slices.SortStableFunc(pvcs, func(a, b *corev1.PersistentVolumeClaim) int {
+			return cmp.Or(
+				cmp.Compare(urgency(metricsData[client.ObjectKeyFromObject(b)]), urgency(metricsData[client.ObjectKeyFromObject(a)])),
+				cmp.Compare(a.Name, b.Name),
+			)
+		})
+
+		if len(pvcs) > 0 {
+			pvcaUrgency[pvca] = urgency(metricsData[client.ObjectKeyFromObject(pvcs[0])])
+		}
+	}
+
+	pvcas := slices.Collect(maps.Keys(pvcaToPVCsMap))
+	slices.SortFunc(pvcas, func(a, b v1alpha1.Autoscaler) int {
+		return cmp.Or(
+			cmp.Compare(pvcaUrgency[b], pvcaUrgency[a]),
+			cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+			cmp.Compare(a.GetName(), b.GetName()),
+		)
+	})
+
+	return pvcas
+}
+
+// urgentPVC is a [corev1.PersistentVolumeClaim] together with the
+// reconciliation of the autoscaler managing it.
+type urgentPVC struct {
+	rec *pvcaReconciliation
+	pvc *corev1.PersistentVolumeClaim
+}
+
+// sortPVCsByUrgency returns the [corev1.PersistentVolumeClaim] objects of all
+// given reconciliations ordered by decreasing urgency, regardless of the
+// autoscaler managing them. This way the quotas and budgets, which are shared
+// between autoscalers, are spent on the fullest volumes first. Ties are broken
+// by namespace and name, to keep the order stable.
+func sortPVCsByUrgency(reconciliations []*pvcaReconciliation, metricsData metricssource.Metrics) []urgentPVC {
+	items := make([]urgentPVC, 0)
+	for _, rec := range reconciliations {
+		for _, pvc := range rec.pvcs {
+			items = append(items, urgentPVC{rec: rec, pvc: pvc})
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b urgentPVC) int {
+		return cmp.Or(
+			cmp.Compare(urgency(metricsData[client.ObjectKeyFromObject(b.pvc)]), urgency(metricsData[client.ObjectKeyFromObject(a.pvc)])),
+			cmp.Compare(a.pvc.Namespace, b.pvc.Namespace),
+			cmp.Compare(a.pvc.Name, b.pvc.Name),
+		)
+	})
+
+	return items
+}
@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+	metricssource "github.com/gardener/pvc-autoscaler/internal/metrics/source"
+)
+
+var _ = Describe("budgetTracker", func() {
+	const (
+		namespace    = "quota-test"
+		storageClass = "quota-storage-class"
+	)
+
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tracker    *budgetTracker
+		pvc        *corev1.PersistentVolumeClaim
+		currSize   resource.Quantity
+	)
+
+	newPVC := func(name, size string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To(storageClass),
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+		}
+	}
+
+	newQuota := func(name string, resourceName corev1.ResourceName, hard, used string) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{resourceName: resource.MustParse(hard)},
+				Used: corev1.ResourceList{resourceName: resource.MustParse(used)},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pvc = newPVC("pvc", "10Gi")
+		currSize = resource.MustParse("10Gi")
+	})
+
+	buildClient := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(storagev1.AddToScheme(scheme)).To(Succeed())
+
+		objs = append(objs,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+			&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: storageClass}},
+		)
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithIndex(&corev1.PersistentVolumeClaim{}, StorageClassIndexKey, storageClassIndexFunc).
+			Build()
+	}
+
+	Describe("#capToBudget", func() {
+		It("should not cap the target size when there are no quotas and budgets", func() {
+			buildClient(pvc)
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeNil())
+			Expect(size).To(Equal(ptr.To(resource.MustParse("20Gi"))))
+		})
+
+		It("should cap the target size to the headroom of the namespace quota", func() {
+			buildClient(pvc, newQuota("storage", corev1.ResourceRequestsStorage, "50Gi", "45Gi"))
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(Equal(&budgetKey{kind: budgetKindNamespaceQuota, namespace: namespace}))
+			Expect(size.String()).To(Equal("15Gi"))
+		})
+
+		It("should use the smallest headroom of all quotas", func() {
+			buildClient(pvc,
+				newQuota("storage", corev1.ResourceRequestsStorage, "100Gi", "10Gi"),
+				newQuota("storage-class", corev1.ResourceName(storageClass+storageClassRequestsStorageSuffix), "20Gi", "18Gi"),
+			)
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(Equal(&budgetKey{kind: budgetKindStorageClassQuota, namespace: namespace, storageClass: storageClass}))
+			Expect(size.String()).To(Equal("12Gi"))
+		})
+
+		It("should round the capped size down to the scaling resolution", func() {
+			buildClient(pvc, newQuota("storage", corev1.ResourceRequestsStorage, "50Gi", "48500Mi"))
+			tracker = newBudgetTracker(fakeClient)
+
+			size, _, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size.String()).To(Equal("12Gi"))
+		})
+
+		It("should return no size when the headroom is less than the scaling resolution", func() {
+			buildClient(pvc, newQuota("storage", corev1.ResourceRequestsStorage, "50Gi", "49.5Gi"))
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).NotTo(BeNil())
+			Expect(size).To(BeNil())
+		})
+
+		It("should respect the storage budget of the namespace", func() {
+			ns := &corev1.Namespace{}
+			buildClient(pvc, newPVC("other", "20Gi"))
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: namespace}, ns)).To(Succeed())
+			ns.Annotations = map[string]string{common.AnnotationStorageBudget: "35Gi"}
+			Expect(fakeClient.Update(ctx, ns)).To(Succeed())
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(Equal(&budgetKey{kind: budgetKindNamespace, namespace: namespace}))
+			Expect(size.String()).To(Equal("15Gi"))
+		})
+
+		It("should respect the storage budget of the storage class across namespaces", func() {
+			sc := &storagev1.StorageClass{}
+			otherPVC := newPVC("other", "20Gi")
+			otherPVC.Namespace = "other-namespace"
+			buildClient(pvc, otherPVC)
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: storageClass}, sc)).To(Succeed())
+			sc.Annotations = map[string]string{common.AnnotationStorageBudget: "32Gi"}
+			Expect(fakeClient.Update(ctx, sc)).To(Succeed())
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(Equal(&budgetKey{kind: budgetKindStorageClass, storageClass: storageClass}))
+			Expect(size.String()).To(Equal("12Gi"))
+		})
+
+		It("should ignore PVCs of other storage classes for the storage class budget", func() {
+			sc := &storagev1.StorageClass{}
+			otherPVC := newPVC("other", "20Gi")
+			otherPVC.Spec.StorageClassName = ptr.To("other-storage-class")
+			buildClient(pvc, otherPVC)
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: storageClass}, sc)).To(Succeed())
+			sc.Annotations = map[string]string{common.AnnotationStorageBudget: "32Gi"}
+			Expect(fakeClient.Update(ctx, sc)).To(Succeed())
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeNil())
+			Expect(size).To(Equal(ptr.To(resource.MustParse("20Gi"))))
+		})
+
+		It("should assume no budget when the namespace and the storage class cannot be looked up", func() {
+			buildClient()
+			Expect(fakeClient.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+			Expect(fakeClient.Delete(ctx, &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: storageClass}})).To(Succeed())
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeNil())
+			Expect(size).To(Equal(ptr.To(resource.MustParse("20Gi"))))
+		})
+
+		It("should fail when the storage budget annotation is invalid", func() {
+			ns := &corev1.Namespace{}
+			buildClient(pvc)
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: namespace}, ns)).To(Succeed())
+			ns.Annotations = map[string]string{common.AnnotationStorageBudget: "invalid"}
+			Expect(fakeClient.Update(ctx, ns)).To(Succeed())
+			tracker = newBudgetTracker(fakeClient)
+
+			_, _, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("20Gi")))
+			Expect(err).To(MatchError(ContainSubstring("invalid pvc.autoscaling.gardener.cloud/storage-budget annotation")))
+		})
+	})
+
+	Describe("#consume", func() {
+		It("should reduce the headroom for subsequent resizes", func() {
+			buildClient(pvc, newQuota("storage", corev1.ResourceRequestsStorage, "50Gi", "30Gi"))
+			tracker = newBudgetTracker(fakeClient)
+
+			size, limit, err := tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("25Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeNil())
+			tracker.consume(pvc, *size, currSize)
+
+			size, limit, err = tracker.capToBudget(ctx, pvc, &currSize, ptr.To(resource.MustParse("25Gi")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).NotTo(BeNil())
+			Expect(size.String()).To(Equal("15Gi"))
+		})
+	})
+
+	Describe("uniform scaling", func() {
+		It("should check the approval before the quotas", func() {
+			ns := &corev1.Namespace{}
+			buildClient(pvc)
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: namespace}, ns)).To(Succeed())
+			ns.Annotations = map[string]string{common.AnnotationStorageBudget: "invalid"}
+			Expect(fakeClient.Update(ctx, ns)).To(Succeed())
+
+			r := &Runner{client: fakeClient, eventRecorder: record.NewFakeRecorder(10), budgets: newBudgetTracker(fakeClient)}
+			largest := newPVC("largest", "20Gi")
+			policy := v1alpha1.VolumePolicy{
+				MaxCapacity:    resource.MustParse("100Gi"),
+				UniformScaling: true,
+				ScaleUp:        &v1alpha1.ScalingRules{ResizeStrategy: v1alpha1.ManualVolumeResizeStrategy},
+			}
+			recommendations := []v1alpha1.VolumeRecommendation{
+				{Name: largest.Name, Target: v1alpha1.TargetRecommendation{Size: ptr.To(resource.MustParse("20Gi"))}},
+				{Name: pvc.Name},
+			}
+			members := []uniformScalingMember{{pvc: largest, maxCapacity: policy.MaxCapacity}, {pvc: pvc, maxCapacity: policy.MaxCapacity}}
+			resizingConditions := &resizingConditionAggregator{}
+
+			r.scaleUniformly(ctx, logr.Discard(), &v1alpha1.PersistentVolumeClaimAutoscaler{}, policy, 0, members, &recommendations, resizingConditions)
+
+			Expect(resizingConditions.pvcConditions).To(HaveKeyWithValue(client.ObjectKeyFromObject(pvc), And(
+				HaveField("Reason", ReasonApprovalPending),
+				HaveField("Message", "waiting for approval of resize to 20Gi"),
+			)))
+		})
+	})
+})
+
+var _ = Describe("urgency", func() {
+	newVolumeInfo := func(availableBytes int) *metricssource.VolumeInfo {
+		return &metricssource.VolumeInfo{
+			CapacityBytes:   100,
+			AvailableBytes:  availableBytes,
+			CapacityInodes:  100,
+			AvailableInodes: 100,
+		}
+	}
+
+	It("should order PVCAs and their PVCs by decreasing utilization", func() {
+		var (
+			pvcA = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-a", Namespace: "default"}}
+			pvcB = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-b", Namespace: "default"}}
+			pvcC = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-c", Namespace: "default"}}
+
+			pvca1 = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "pvca-1", Namespace: "default"}}
+			pvca2 = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "pvca-2", Namespace: "default"}}
+		)
+
+		pvcaToPVCsMap := map[v1alpha1.Autoscaler][]*corev1.PersistentVolumeClaim{
+			pvca1: {pvcA},
+			pvca2: {pvcB, pvcC},
+		}
+		metricsData := metricssource.Metrics{
+			client.ObjectKeyFromObject(pvcA): newVolumeInfo(20),
+			client.ObjectKeyFromObject(pvcB): newVolumeInfo(50),
+			client.ObjectKeyFromObject(pvcC): newVolumeInfo(5),
+		}
+
+		Expect(sortPVCAsByUrgency(pvcaToPVCsMap, metricsData)).To(Equal([]v1alpha1.Autoscaler{pvca2, pvca1}))
+		Expect(pvcaToPVCsMap[pvca2]).To(Equal([]*corev1.PersistentVolumeClaim{pvcC, pvcB}))
+	})
+
+	It("should order the PVCs of all PVCAs by decreasing utilization", func() {
+		var (
+			pvcA81 = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-a-81", Namespace: "default"}}
+			pvcA50 = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-a-50", Namespace: "default"}}
+			pvcB95 = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-b-95", Namespace: "default"}}
+			pvcB70 = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc-b-70", Namespace: "default"}}
+
+			recA = &pvcaReconciliation{pvcs: []*corev1.PersistentVolumeClaim{pvcA81, pvcA50}}
+			recB = &pvcaReconciliation{pvcs: []*corev1.PersistentVolumeClaim{pvcB95, pvcB70}}
+		)
+
+		metricsData := metricssource.Metrics{
+			client.ObjectKeyFromObject(pvcA81): newVolumeInfo(19),
+			client.ObjectKeyFromObject(pvcA50): newVolumeInfo(50),
+			client.ObjectKeyFromObject(pvcB95): newVolumeInfo(5),
+			client.ObjectKeyFromObject(pvcB70): newVolumeInfo(30),
+		}
+
+		Expect(sortPVCsByUrgency([]*pvcaReconciliation{recA, recB}, metricsData)).To(Equal([]urgentPVC{
+			{rec: recB, pvc: pvcB95},
+			{rec: recA, pvc: pvcA81},
+			{rec: recB, pvc: pvcB70},
+			{rec: recA, pvc: pvcA50},
+		}))
+	})
+})
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+	"github.com/gardener/pvc-autoscaler/internal/target/pvcfetcher"
+)
+
+// pvcIndex maps the [corev1.PersistentVolumeClaim] objects to the key of the
+// [v1alpha1.Autoscaler] managing them. The key of a
+// [v1alpha1.ClusterPersistentVolumeClaimAutoscaler] has an empty namespace.
+type pvcIndex struct {
+	mu     sync.RWMutex
+	owners map[client.ObjectKey]client.ObjectKey
+}
+
+// newPVCIndex creates a new empty [pvcIndex].
+func newPVCIndex() *pvcIndex {
+	return &pvcIndex{owners: make(map[client.ObjectKey]client.ObjectKey)}
+}
+
+// update replaces the PVCs of the autoscaler with the given key.
+func (i *pvcIndex) update(pvcaKey client.ObjectKey, pvcs []*corev1.PersistentVolumeClaim) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for pvcKey, owner := range i.owners {
+		if owner == pvcaKey {
+			delete(i.owners, pvcKey)
+		}
+	}
+	for _, pvc := range pvcs {
+		i.owners[client.ObjectKeyFromObject(pvc)] = pvcaKey
+	}
+}
+
+// owner returns the key of the autoscaler managing the PVC with the given key.
+func (i *pvcIndex) owner(pvcKey client.ObjectKey) (client.ObjectKey, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	owner, ok := i.owners[pvcKey]
+
+	return owner, ok
+}
+
+// SetupWithManager registers a controller with the manager, which reconciles
+// an autoscaler as soon as its spec, its PersistentVolumeClaims, the pods
+// using them or the resize requests for them change. The controller reuses
+// the metrics fetched by the latest periodic reconciliation, so that the
+// scheduled checks remain the only trigger for resizes based on the
+// utilization of the volumes.
+func (r *Runner) SetupWithManager(mgr ctrl.Manager) error {
+	return builder.ControllerManagedBy(mgr).
+		Named("pvca-event-reconciler").
+		For(&v1alpha1.PersistentVolumeClaimAutoscaler{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&v1alpha1.ClusterPersistentVolumeClaimAutoscaler{},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&corev1.PersistentVolumeClaim{},
+			handler.EnqueueRequestsFromMapFunc(r.autoscalersForPVC),
+			builder.WithPredicates(pvcChangedPredicate()),
+		).
+		Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(r.autoscalersForPod),
+			builder.WithPredicates(podPhaseChangedPredicate()),
+		).
+		Watches(
+			&v1alpha1.PersistentVolumeClaimResizeRequest{},
+			handler.EnqueueRequestsFromMapFunc(r.autoscalersForResizeRequest),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{}, r.reconcileOne(ctx, req.NamespacedName)
+		}))
+}
+
+// pvcChangedPredicate filters the updates of a [corev1.PersistentVolumeClaim],
+// which do not affect the autoscaler managing it.
+func pvcChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPVC, okOld := e.ObjectOld.(*corev1.PersistentVolumeClaim)
+			newPVC, okNew := e.ObjectNew.(*corev1.PersistentVolumeClaim)
+			if !okOld || !okNew {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldPVC.Spec, newPVC.Spec) ||
+				!apiequality.Semantic.DeepEqual(oldPVC.Status, newPVC.Status) ||
+				!apiequality.Semantic.DeepEqual(oldPVC.Labels, newPVC.Labels) ||
+				!apiequality.Semantic.DeepEqual(oldPVC.Annotations, newPVC.Annotations)
+		},
+	}
+}
+
+// podPhaseChangedPredicate filters the updates of a [corev1.Pod], which do not
+// change its phase.
+func podPhaseChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPod, okOld := e.ObjectOld.(*corev1.Pod)
+			newPod, okNew := e.ObjectNew.(*corev1.Pod)
+
+			return okOld && okNew && oldPod.Status.Phase != newPod.Status.Phase
+		},
+	}
+}
+
+// autoscalersForPVC returns the requests for the autoscaler managing the
+// given [corev1.PersistentVolumeClaim]. For a PVC which is not managed yet,
+// all autoscalers which might select it are returned.
+func (r *Runner) autoscalersForPVC(ctx context.Context, obj client.Object) []reconcile.Request {
+	if owner, ok := r.pvcIndex.owner(client.ObjectKeyFromObject(obj)); ok {
+		return []reconcile.Request{{NamespacedName: owner}}
+	}
+
+	return r.autoscalersForNamespace(ctx, obj.GetNamespace())
+}
+
+// autoscalersForPod returns the requests for the autoscalers managing the
+// PersistentVolumeClaims used by the given [corev1.Pod]. For a pod whose PVCs
+// are not managed yet, all autoscalers which might select them are returned.
+func (r *Runner) autoscalersForPod(ctx context.Context, obj client.Object) []reconcile.Request {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, claimName := range pvcfetcher.ClaimNames(pod) {
+		owner, ok := r.pvcIndex.owner(client.ObjectKey{Namespace: pod.Namespace, Name: claimName})
+		if !ok {
+			return r.autoscalersForNamespace(ctx, pod.Namespace)
+		}
+
+		request := reconcile.Request{NamespacedName: owner}
+		if !slices.Contains(requests, request) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+// autoscalersForResizeRequest returns the request for the autoscaler managing
+// the PersistentVolumeClaim of the given
+// [v1alpha1.PersistentVolumeClaimResizeRequest]. Requests for PVCs which are
+// not managed are ignored, and reported as not managed by the next scheduled
+// check.
+func (r *Runner) autoscalersForResizeRequest(_ context.Context, obj client.Object) []reconcile.Request {
+	request, ok := obj.(*v1alpha1.PersistentVolumeClaimResizeRequest)
+	if !ok {
+		return nil
+	}
+
+	owner, ok := r.pvcIndex.owner(client.ObjectKey{Namespace: request.Namespace, Name: request.Spec.ClaimName})
+	if !ok {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: owner}}
+}
+
+// autoscalersForNamespace returns the requests for all autoscalers, which
+// might select PersistentVolumeClaims in the given namespace.
+func (r *Runner) autoscalersForNamespace(ctx context.Context, namespace string) []reconcile.Request {
+	logger := log.FromContext(ctx, "controller", common.ControllerName)
+
+	var (
+		pvcaList  v1alpha1.PersistentVolumeClaimAutoscalerList
+		cpvcaList v1alpha1.ClusterPersistentVolumeClaimAutoscalerList
+	)
+	if err := r.client.List(ctx, &pvcaList, client.InNamespace(namespace), client.MatchingFields{v1alpha1.AutoscalerNameIndexKey: r.autoscalerName}); err != nil {
+		logger.Error(err, "failed to list persistentvolumeclaimautoscalers", "namespace", namespace)
+	}
+	if err := r.client.List(ctx, &cpvcaList, client.MatchingFields{v1alpha1.AutoscalerNameIndexKey: r.autoscalerName}); err != nil {
+		logger.Error(err, "failed to list clusterpersistentvolumeclaimautoscalers")
+	}
+
+	requests := make([]reconcile.Request, 0, len(pvcaList.Items)+len(cpvcaList.Items))
+	for i := range pvcaList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pvcaList.Items[i])})
+	}
+	for i := range cpvcaList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&cpvcaList.Items[i])})
+	}
+
+	return requests
+}
+
+// reconcileOne reconciles the autoscaler with the given key using the metrics
+// of the latest periodic reconciliation. Nothing is done before metrics have
+// been fetched for the first time.
+func (r *Runner) reconcileOne(ctx context.Context, key client.ObjectKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	logger := log.FromContext(ctx, "controller", common.ControllerName)
+
+	if r.metricsData == nil {
+		return nil
+	}
+
+	autoscalers, err := r.listAutoscalers(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The autoscaler has been deleted, or is reconciled by another instance
+	index := slices.IndexFunc(autoscalers, func(pvca v1alpha1.Autoscaler) bool {
+		return client.ObjectKeyFromObject(pvca) == key
+	})
+	if index < 0 {
+		r.forgetAutoscaler(key)
+
+		return nil
+	}
+	pvca := autoscalers[index]
+
+	// New autoscalers and autoscalers, whose check interval has been
+	// shortened, are checked right away instead of waiting for the next
+	// scheduled check.
+	now := time.Now()
+	if at, ok := r.schedule.get(key); !ok || at.After(now.Add(r.checkInterval(pvca))) {
+		r.schedule.set(key, now)
+		r.wake()
+	}
+
+	pvcaToPVCsMap := r.fetchPVCsForPVCAs(ctx, logger, []v1alpha1.Autoscaler{pvca})
+	if _, ok := pvcaToPVCsMap[pvca]; !ok {
+		r.pvcIndex.update(key, nil)
+
+		return nil
+	}
+
+	others, err := r.listOtherAutoscalers(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.fetchPrecedingPVCs(ctx, slices.Concat(autoscalers, others), []v1alpha1.Autoscaler{pvca}, pvcaToPVCsMap)
+	r.overlappingPVCs = resolvePVCOwnership(logger, pvcaToPVCsMap)
+
+	r.budgets.reset()
+	if err := r.resizeRequests.reset(ctx); err != nil {
+		logger.Error(err, "failed to list persistentvolumeclaimresizerequests")
+	}
+
+	r.reconcilePVCA(ctx, logger, pvca, pvcaToPVCsMap[pvca], r.metricsData, false)
+	r.pvcIndex.update(key, pvcaToPVCsMap[pvca])
+
+	return nil
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/metrics"
+	metricssource "github.com/gardener/pvc-autoscaler/internal/metrics/source"
+	"github.com/gardener/pvc-autoscaler/internal/metrics/source/fake"
+	"github.com/gardener/pvc-autoscaler/internal/target/pvcfetcher"
+)
+
+var _ = Describe("pvcIndex", func() {
+	var (
+		index *pvcIndex
+
+		pvca  *v1alpha1.PersistentVolumeClaimAutoscaler
+		cpvca *v1alpha1.ClusterPersistentVolumeClaimAutoscaler
+	)
+
+	newPVC := func(name string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+
+	BeforeEach(func() {
+		index = newPVCIndex()
+		pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "pvca", Namespace: "default"}}
+		cpvca = &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "cpvca"}}
+
+		index.update(client.ObjectKeyFromObject(pvca), []*corev1.PersistentVolumeClaim{newPVC("pvc-a"), newPVC("pvc-b")})
+		index.update(client.ObjectKeyFromObject(cpvca), []*corev1.PersistentVolumeClaim{newPVC("pvc-c")})
+	})
+
+	It("should return the autoscaler managing a PVC", func() {
+		owner, ok := index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-a"})
+		Expect(ok).To(BeTrue())
+		Expect(owner).To(Equal(client.ObjectKey{Namespace: "default", Name: "pvca"}))
+
+		owner, ok = index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-c"})
+		Expect(ok).To(BeTrue())
+		Expect(owner).To(Equal(client.ObjectKey{Name: "cpvca"}))
+
+		_, ok = index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-d"})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should replace the PVCs of an autoscaler on update", func() {
+		index.update(client.ObjectKeyFromObject(pvca), []*corev1.PersistentVolumeClaim{newPVC("pvc-b"), newPVC("pvc-d")})
+
+		_, ok := index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-a"})
+		Expect(ok).To(BeFalse())
+		_, ok = index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-d"})
+		Expect(ok).To(BeTrue())
+		_, ok = index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-c"})
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should forget the PVCs of a deleted autoscaler", func() {
+		index.update(client.ObjectKeyFromObject(pvca), nil)
+
+		_, ok := index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-a"})
+		Expect(ok).To(BeFalse())
+		_, ok = index.owner(client.ObjectKey{Namespace: "default", Name: "pvc-b"})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should map a pod to the autoscalers managing its PVCs", func() {
+		r := &Runner{pvcIndex: index}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{
+				{Name: "a", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-a"}}},
+				{Name: "b", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-b"}}},
+				{Name: "c", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-c"}}},
+				{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+			}},
+		}
+
+		Expect(r.autoscalersForPod(parentCtx, pod)).To(ConsistOf(
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "pvca"}},
+			reconcile.Request{NamespacedName: client.ObjectKey{Name: "cpvca"}},
+		))
+	})
+
+	It("should map a resize request to the autoscaler managing its PVC", func() {
+		r := &Runner{pvcIndex: index}
+		request := &v1alpha1.PersistentVolumeClaimResizeRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "grow", Namespace: "default"},
+			Spec:       v1alpha1.PersistentVolumeClaimResizeRequestSpec{ClaimName: "pvc-c"},
+		}
+
+		Expect(r.autoscalersForResizeRequest(parentCtx, request)).To(ConsistOf(
+			reconcile.Request{NamespacedName: client.ObjectKey{Name: "cpvca"}},
+		))
+
+		request.Spec.ClaimName = "pvc-d"
+		Expect(r.autoscalersForResizeRequest(parentCtx, request)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("event predicates", func() {
+	It("should only pass PVC updates which change more than the metadata", func() {
+		oldPVC := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc", ResourceVersion: "1"}}
+		newPVC := oldPVC.DeepCopy()
+		newPVC.ResourceVersion = "2"
+		Expect(pvcChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldPVC, ObjectNew: newPVC})).To(BeFalse())
+
+		newPVC.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")}
+		Expect(pvcChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldPVC, ObjectNew: newPVC})).To(BeTrue())
+	})
+
+	It("should only pass pod updates which change the phase", func() {
+		oldPod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}
+		newPod := oldPod.DeepCopy()
+		newPod.Status.Message = "pulling image"
+		Expect(podPhaseChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldPod, ObjectNew: newPod})).To(BeFalse())
+
+		newPod.Status.Phase = corev1.PodRunning
+		Expect(podPhaseChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldPod, ObjectNew: newPod})).To(BeTrue())
+	})
+})
+
+// fakePVCFetcher is a [pvcfetcher.Fetcher], which returns the same PVCs for
+// every autoscaler.
+type fakePVCFetcher struct {
+	pvcfetcher.Fetcher
+
+	pvcs []*corev1.PersistentVolumeClaim
+}
+
+func (f *fakePVCFetcher) FetchTargets(_ context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler) ([]pvcfetcher.Target, error) {
+	return []pvcfetcher.Target{{Ref: pvca.Spec.TargetRef, PVCs: f.pvcs}}, nil
+}
+
+func (f *fakePVCFetcher) FetchVolumeClaimTemplates(context.Context, *v1alpha1.PersistentVolumeClaimAutoscaler, []*corev1.PersistentVolumeClaim) (map[string]string, error) {
+	return nil, nil
+}
+
+var _ = Describe("#reconcileOne", func() {
+	var scheme *runtime.Scheme
+
+	newClientBuilder := func() *fakeclient.ClientBuilder {
+		autoscalerName := func(obj client.Object) []string {
+			return []string{obj.(v1alpha1.Autoscaler).GetAutoscalerName()}
+		}
+
+		return fakeclient.NewClientBuilder().
+			WithScheme(scheme).
+			WithIndex(&v1alpha1.PersistentVolumeClaimAutoscaler{}, v1alpha1.AutoscalerNameIndexKey, autoscalerName).
+			WithIndex(&v1alpha1.ClusterPersistentVolumeClaimAutoscaler{}, v1alpha1.AutoscalerNameIndexKey, autoscalerName)
+	}
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	It("should forget a deleted autoscaler and delete its cost metrics", func() {
+		r := &Runner{
+			client:        newClientBuilder().Build(),
+			metricsData:   metricssource.Metrics{},
+			pvcIndex:      newPVCIndex(),
+			schedule:      newSchedule(),
+			reportedCosts: make(map[client.ObjectKey][]client.ObjectKey),
+		}
+
+		var (
+			pvcaKey = client.ObjectKey{Namespace: "default", Name: "deleted-pvca"}
+			pvcKey  = client.ObjectKey{Namespace: "default", Name: "deleted-pvca-data"}
+		)
+		r.schedule.set(pvcaKey, time.Now())
+		r.pvcIndex.update(pvcaKey, []*corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: pvcKey.Name, Namespace: pvcKey.Namespace}}})
+		r.reportedCosts[pvcaKey] = []client.ObjectKey{pvcKey}
+		metrics.MonthlyCost.WithLabelValues(pvcKey.Namespace, pvcKey.Name, "current").Set(1)
+		metrics.AutoscalerMonthlyCost.WithLabelValues(pvcaKey.Namespace, pvcaKey.Name, "current").Set(1)
+
+		Expect(r.reconcileOne(context.Background(), pvcaKey)).To(Succeed())
+
+		_, ok := r.schedule.get(pvcaKey)
+		Expect(ok).To(BeFalse())
+		_, ok = r.pvcIndex.owner(pvcKey)
+		Expect(ok).To(BeFalse())
+		Expect(r.reportedCosts).NotTo(HaveKey(pvcaKey))
+		Expect(metrics.MonthlyCost.DeleteLabelValues(pvcKey.Namespace, pvcKey.Name, "current")).To(BeFalse())
+		Expect(metrics.AutoscalerMonthlyCost.DeleteLabelValues(pvcaKey.Namespace, pvcaKey.Name, "current")).To(BeFalse())
+	})
+
+	It("should not patch the status, when an event-driven reconciliation changes nothing", func() {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default", UID: "pvc-uid"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")}},
+			},
+		}
+		pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvca", Namespace: "default"},
+			Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+				TargetRef:      autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: pvc.Name},
+				VolumePolicies: []v1alpha1.VolumePolicy{{MaxCapacity: resource.MustParse("3Gi")}},
+			},
+		}
+
+		c := newClientBuilder().
+			WithObjects(pvc, pvca).
+			WithStatusSubresource(&v1alpha1.PersistentVolumeClaimAutoscaler{}).
+			Build()
+		r, err := New(
+			WithClient(c),
+			WithEventRecorder(record.NewFakeRecorder(100)),
+			WithMetricsSource(fake.New()),
+			WithPVCFetcher(&fakePVCFetcher{pvcs: []*corev1.PersistentVolumeClaim{pvc}}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		r.metricsData = metricssource.Metrics{}
+
+		key := client.ObjectKeyFromObject(pvca)
+		Expect(r.reconcileOne(context.Background(), key)).To(Succeed())
+		Expect(c.Get(context.Background(), key, pvca)).To(Succeed())
+		Expect(pvca.Status.ManagedPVCs).To(Equal(1))
+		resourceVersion := pvca.ResourceVersion
+
+		Expect(r.reconcileOne(context.Background(), key)).To(Succeed())
+		Expect(c.Get(context.Background(), key, pvca)).To(Succeed())
+		Expect(pvca.ResourceVersion).To(Equal(resourceVersion))
+	})
+})
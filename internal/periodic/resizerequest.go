@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+// resizeRequestTracker keeps track of the open
+// [v1alpha1.PersistentVolumeClaimResizeRequest] objects within a single
+// reconciliation cycle.
+type resizeRequestTracker struct {
+	client client.Client
+
+	// requests maps a PVC to its open requests, ordered by creation time.
+	requests map[client.ObjectKey][]*v1alpha1.PersistentVolumeClaimResizeRequest
+}
+
+// newResizeRequestTracker creates a new [resizeRequestTracker], which uses the
+// given client for listing the requests.
+func newResizeRequestTracker(c client.Client) *resizeRequestTracker {
+	return &resizeRequestTracker{
+		client:   c,
+		requests: make(map[client.ObjectKey][]*v1alpha1.PersistentVolumeClaimResizeRequest),
+	}
+}
+
+// reset lists the requests, which have neither succeeded nor failed yet. It is
+// called at the beginning of every reconciliation cycle.
+func (t *resizeRequestTracker) reset(ctx context.Context) error {
+	t.requests = make(map[client.ObjectKey][]*v1alpha1.PersistentVolumeClaimResizeRequest)
+
+	var requestList v1alpha1.PersistentVolumeClaimResizeRequestList
+	if err := t.client.List(ctx, &requestList); err != nil {
+		return err
+	}
+
+	for i := range requestList.Items {
+		request := &requestList.Items[i]
+		if request.IsFinished() {
+			continue
+		}
+
+		key := client.ObjectKey{Namespace: request.Namespace, Name: request.Spec.ClaimName}
+		t.requests[key] = append(t.requests[key], request)
+	}
+
+	for _, requests := range t.requests {
+		slices.SortFunc(requests, func(a, b *v1alpha1.PersistentVolumeClaimResizeRequest) int {
+			return cmp.Or(
+				a.CreationTimestamp.Compare(b.CreationTimestamp.Time),
+				cmp.Compare(a.Name, b.Name),
+			)
+		})
+	}
+
+	return nil
+}
+
+// next returns the request for the [corev1.PersistentVolumeClaim], which is
+// processed next. A request in progress takes precedence over the oldest
+// pending request. It returns nil, if there are no open requests for the PVC.
+func (t *resizeRequestTracker) next(pvc *corev1.PersistentVolumeClaim) *v1alpha1.PersistentVolumeClaimResizeRequest {
+	requests := t.requests[client.ObjectKeyFromObject(pvc)]
+	for _, request := range requests {
+		if request.Status.Phase == v1alpha1.ResizeRequestPhaseInProgress {
+			return request
+		}
+	}
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	return requests[0]
+}
+
+// reconcileResizeRequest executes the [v1alpha1.PersistentVolumeClaimResizeRequest]
+// for the [corev1.PersistentVolumeClaim], or completes it once the resize is
+// no longer in progress. Requests wait while resizing is suspended or paused,
+// or while another resize is in progress. It returns whether the PVC has been
+// patched.
+func (r *Runner) reconcileResizeRequest(
+	ctx context.Context,
+	logger logr.Logger,
+	request *v1alpha1.PersistentVolumeClaimResizeRequest,
+	pvc *corev1.PersistentVolumeClaim,
+	policy v1alpha1.VolumePolicy,
+	paused bool,
+	inProgress bool,
+	volumeRecommendation *v1alpha1.VolumeRecommendation,
+	resizingConditions *resizingConditionAggregator,
+) bool {
+	logger = logger.WithValues("resizeRequest", client.ObjectKeyFromObject(request))
+	original := request.DeepCopy()
+
+	started := false
+	switch {
+	case request.Status.Phase == v1alpha1.ResizeRequestPhaseInProgress:
+		if !inProgress {
+			r.completeResizeRequest(request, pvc)
+		}
+	case paused:
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhasePending, "", "resizing of the PersistentVolumeClaim is suspended or paused")
+	case inProgress:
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhasePending, "", "waiting for the resize in progress to complete")
+	default:
+		started = r.executeResizeRequest(ctx, logger, request, pvc, policy, volumeRecommendation, resizingConditions)
+	}
+
+	if equality.Semantic.DeepEqual(original.Status, request.Status) {
+		return started
+	}
+
+	if err := r.client.Status().Patch(ctx, request, client.MergeFrom(original)); err != nil {
+		logger.Error(err, "failed to update resize request status")
+	}
+
+	return started
+}
+
+// executeResizeRequest resizes the [corev1.PersistentVolumeClaim] to the
+// requested size, capped by the quotas and storage budgets. Requests for sizes
+// which are not larger than the current size, or which exceed the max capacity
+// of the volume policy, fail, as well as requests for which the quotas and
+// storage budgets leave no headroom. It returns whether the PVC has been
+// patched.
+func (r *Runner) executeResizeRequest(
+	ctx context.Context,
+	logger logr.Logger,
+	request *v1alpha1.PersistentVolumeClaimResizeRequest,
+	pvc *corev1.PersistentVolumeClaim,
+	policy v1alpha1.VolumePolicy,
+	volumeRecommendation *v1alpha1.VolumeRecommendation,
+	resizingConditions *resizingConditionAggregator,
+) bool {
+	currSpecSize := pvc.Spec.Resources.Requests.Storage()
+	size := request.Spec.Size.DeepCopy()
+
+	if size.Cmp(*currSpecSize) <= 0 {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseFailed, "", fmt.Sprintf("requested size %s is not larger than the current size %s", size.String(), currSpecSize.String()))
+
+		return false
+	}
+
+	if size.Cmp(policy.MaxCapacity) > 0 {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseFailed, "", fmt.Sprintf("requested size %s exceeds the max capacity %s", size.String(), policy.MaxCapacity.String()))
+
+		return false
+	}
+
+	// Requests are capped by the quotas and storage budgets like any other
+	// resize. They only fail, when there is no headroom left at all.
+	allowedSize, limit, err := r.budgets.capToBudget(ctx, pvc, currSpecSize, &size)
+	if err != nil {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseFailed, "", fmt.Sprintf("could not determine storage quota: %s", err.Error()))
+
+		return false
+	}
+
+	if allowedSize == nil {
+		r.recordQuotaExceeded(logger, pvc, currSpecSize, &size, *limit, resizingConditions)
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseFailed, v1alpha1.ResizeRequestReasonQuotaExceeded, fmt.Sprintf("resizing to %s would exceed the %s, which has no headroom left", size.String(), limit))
+
+		return false
+	}
+
+	logger.Info("executing resize request", "size", size.String(), "requestedBy", request.Spec.RequestedBy)
+	scalingReason := fmt.Sprintf("resize request %s", request.Name)
+	historyMessage := fmt.Sprintf("requested by %s via %s: %s", request.Spec.RequestedBy, request.Name, request.Spec.Reason)
+	updatedRecommendation, _, err := r.applyResize(ctx, logger, pvc, &size, v1alpha1.ResizeTriggerManual, scalingReason, historyMessage, *volumeRecommendation, resizingConditions)
+	*volumeRecommendation = updatedRecommendation
+	if err != nil {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseFailed, "", fmt.Sprintf("could not resize PersistentVolumeClaim: %s", err.Error()))
+
+		return false
+	}
+
+	request.Status.From = ptr.To(currSpecSize.DeepCopy())
+	request.Status.To = ptr.To(updatedRecommendation.Target.Size.DeepCopy())
+	request.Status.StartTime = ptr.To(metav1.Now())
+	if limit != nil {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseInProgress, v1alpha1.ResizeRequestReasonCapped, fmt.Sprintf("resizing from %s to %s, capped from the requested size %s by the %s", request.Status.From.String(), request.Status.To.String(), size.String(), limit))
+
+		return true
+	}
+	r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseInProgress, "", fmt.Sprintf("resizing from %s to %s", request.Status.From.String(), request.Status.To.String()))
+
+	return true
+}
+
+// completeResizeRequest sets the outcome of a
+// [v1alpha1.PersistentVolumeClaimResizeRequest] in progress, once the resize
+// of the [corev1.PersistentVolumeClaim] is no longer in progress. The request
+// has succeeded when the capacity of the PVC has reached the size to which it
+// has been resized.
+func (r *Runner) completeResizeRequest(request *v1alpha1.PersistentVolumeClaimResizeRequest, pvc *corev1.PersistentVolumeClaim) {
+	capacity := pvc.Status.Capacity.Storage()
+	if request.Status.To != nil && capacity.Cmp(*request.Status.To) < 0 {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseFailed, request.Status.Reason, fmt.Sprintf("capacity %s of the PersistentVolumeClaim has not reached %s", capacity.String(), request.Status.To.String()))
+
+		return
+	}
+
+	if request.Status.Reason == v1alpha1.ResizeRequestReasonCapped {
+		r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseSucceeded, request.Status.Reason, fmt.Sprintf("PersistentVolumeClaim has been resized to %s, capped from the requested size %s", capacity.String(), request.Spec.Size.String()))
+
+		return
+	}
+
+	r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhaseSucceeded, "", fmt.Sprintf("PersistentVolumeClaim has been resized to %s", capacity.String()))
+}
+
+// reportUnmanagedResizeRequests reports the open
+// [v1alpha1.PersistentVolumeClaimResizeRequest] objects for PVCs, which are not
+// managed by any autoscaler of the [Runner], as pending with the NotManaged
+// reason. Only requests which have not been processed yet are reported, as the
+// PVC might be managed by another instance with a different autoscaler name,
+// which then takes over the request.
+func (r *Runner) reportUnmanagedResizeRequests(ctx context.Context, logger logr.Logger) {
+	for key, requests := range r.resizeRequests.requests {
+		if _, ok := r.pvcIndex.owner(key); ok {
+			continue
+		}
+
+		for _, request := range requests {
+			if request.Status.Phase != "" {
+				continue
+			}
+
+			original := request.DeepCopy()
+			r.setResizeRequestPhase(request, v1alpha1.ResizeRequestPhasePending, v1alpha1.ResizeRequestReasonNotManaged, fmt.Sprintf("PersistentVolumeClaim %s is not managed by any autoscaler", key.Name))
+			if err := r.client.Status().Patch(ctx, request, client.MergeFrom(original)); err != nil {
+				logger.Error(err, "failed to update resize request status", "resizeRequest", client.ObjectKeyFromObject(request))
+			}
+		}
+	}
+}
+
+// setResizeRequestPhase sets the phase, reason and message of the
+// [v1alpha1.PersistentVolumeClaimResizeRequest]. Changes of the phase are
+// reported via an event, and the completion time is set once the request has
+// succeeded or failed.
+func (r *Runner) setResizeRequestPhase(request *v1alpha1.PersistentVolumeClaimResizeRequest, phase v1alpha1.ResizeRequestPhase, reason v1alpha1.ResizeRequestReason, message string) {
+	phaseChanged := request.Status.Phase != phase
+	request.Status.Phase = phase
+	request.Status.Reason = reason
+	request.Status.Message = message
+
+	if request.IsFinished() && request.Status.CompletionTime == nil {
+		request.Status.CompletionTime = ptr.To(metav1.Now())
+	}
+
+	if !phaseChanged {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if phase == v1alpha1.ResizeRequestPhaseFailed {
+		eventType = corev1.EventTypeWarning
+	}
+	r.eventRecorder.Eventf(request, eventType, fmt.Sprintf("ResizeRequest%s", phase), "%s", message)
+}
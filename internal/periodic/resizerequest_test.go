@@ -0,0 +1,351 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+)
+
+var _ = Describe("resizeRequestTracker", func() {
+	const namespace = "resize-request-test"
+
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tracker    *resizeRequestTracker
+		pvc        *corev1.PersistentVolumeClaim
+	)
+
+	newRequest := func(name, claimName string, created time.Time, phase v1alpha1.ResizeRequestPhase) *v1alpha1.PersistentVolumeClaimResizeRequest {
+		return &v1alpha1.PersistentVolumeClaimResizeRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, CreationTimestamp: metav1.NewTime(created)},
+			Spec:       v1alpha1.PersistentVolumeClaimResizeRequestSpec{ClaimName: claimName, Size: resource.MustParse("5Gi"), Reason: "test"},
+			Status:     v1alpha1.PersistentVolumeClaimResizeRequestStatus{Phase: phase},
+		}
+	}
+
+	buildTracker := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+		tracker = newResizeRequestTracker(fakeClient)
+		Expect(tracker.reset(ctx)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pvc = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: namespace}}
+	})
+
+	It("should return nil without open requests", func() {
+		now := time.Now()
+		buildTracker(
+			newRequest("succeeded", "pvc", now, v1alpha1.ResizeRequestPhaseSucceeded),
+			newRequest("other-pvc", "other", now, ""),
+		)
+
+		Expect(tracker.next(pvc)).To(BeNil())
+	})
+
+	It("should return the oldest pending request", func() {
+		now := time.Now()
+		buildTracker(
+			newRequest("newer", "pvc", now, ""),
+			newRequest("older", "pvc", now.Add(-time.Hour), v1alpha1.ResizeRequestPhasePending),
+		)
+
+		Expect(tracker.next(pvc)).To(HaveField("Name", "older"))
+	})
+
+	It("should prefer the request in progress", func() {
+		now := time.Now()
+		buildTracker(
+			newRequest("older", "pvc", now.Add(-time.Hour), ""),
+			newRequest("in-progress", "pvc", now, v1alpha1.ResizeRequestPhaseInProgress),
+		)
+
+		Expect(tracker.next(pvc)).To(HaveField("Name", "in-progress"))
+	})
+})
+
+var _ = Describe("reconcileResizeRequest", func() {
+	const namespace = "resize-request-test"
+
+	var (
+		ctx                context.Context
+		fakeClient         client.Client
+		recorder           *record.FakeRecorder
+		runner             *Runner
+		pvc                *corev1.PersistentVolumeClaim
+		request            *v1alpha1.PersistentVolumeClaimResizeRequest
+		policy             v1alpha1.VolumePolicy
+		recommendation     v1alpha1.VolumeRecommendation
+		resizingConditions *resizingConditionAggregator
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: namespace},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To("default"),
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		}
+		request = &v1alpha1.PersistentVolumeClaimResizeRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "grow", Namespace: namespace},
+			Spec: v1alpha1.PersistentVolumeClaimResizeRequestSpec{
+				ClaimName:   pvc.Name,
+				Size:        resource.MustParse("5Gi"),
+				Reason:      "bulk import",
+				RequestedBy: "jane",
+			},
+		}
+		policy = v1alpha1.VolumePolicy{MaxCapacity: resource.MustParse("10Gi")}
+		recommendation = v1alpha1.VolumeRecommendation{Name: pvc.Name}
+		resizingConditions = &resizingConditionAggregator{}
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(storagev1.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				pvc,
+				request,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+				&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			).
+			WithStatusSubresource(&v1alpha1.PersistentVolumeClaimResizeRequest{}).
+			Build()
+		recorder = record.NewFakeRecorder(10)
+		runner = &Runner{
+			client:        fakeClient,
+			eventRecorder: recorder,
+			budgets:       newBudgetTracker(fakeClient),
+		}
+	})
+
+	getRequest := func() *v1alpha1.PersistentVolumeClaimResizeRequest {
+		var updated v1alpha1.PersistentVolumeClaimResizeRequest
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(request), &updated)).To(Succeed())
+
+		return &updated
+	}
+
+	It("should resize the PVC to the requested size", func() {
+		started := runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)
+		Expect(started).To(BeTrue())
+
+		var updatedPVC corev1.PersistentVolumeClaim
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), &updatedPVC)).To(Succeed())
+		Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("5Gi")))
+
+		Expect(getRequest().Status).To(And(
+			HaveField("Phase", v1alpha1.ResizeRequestPhaseInProgress),
+			HaveField("From", Equal(ptr.To(resource.MustParse("1Gi")))),
+			HaveField("To", Equal(ptr.To(resource.MustParse("5Gi")))),
+			HaveField("StartTime", Not(BeNil())),
+		))
+		Expect(recommendation.ResizeHistory).To(ConsistOf(And(
+			HaveField("Trigger", v1alpha1.ResizeTriggerManual),
+			HaveField("Message", "requested by jane via grow: bulk import"),
+		)))
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal ResizingStorage")))
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal ResizeRequestInProgress")))
+	})
+
+	It("should fail when the requested size exceeds the max capacity", func() {
+		policy.MaxCapacity = resource.MustParse("4Gi")
+
+		started := runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)
+		Expect(started).To(BeFalse())
+
+		Expect(getRequest().Status).To(And(
+			HaveField("Phase", v1alpha1.ResizeRequestPhaseFailed),
+			HaveField("Message", "requested size 5Gi exceeds the max capacity 4Gi"),
+			HaveField("CompletionTime", Not(BeNil())),
+		))
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning ResizeRequestFailed")))
+	})
+
+	Context("with a storage budget", func() {
+		setBudget := func(budget string) {
+			ns := &corev1.Namespace{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: namespace}, ns)).To(Succeed())
+			ns.Annotations = map[string]string{common.AnnotationStorageBudget: budget}
+			Expect(fakeClient.Update(ctx, ns)).To(Succeed())
+		}
+
+		It("should cap the resize and report the capped size", func() {
+			setBudget("3Gi")
+
+			Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)).To(BeTrue())
+
+			var updatedPVC corev1.PersistentVolumeClaim
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), &updatedPVC)).To(Succeed())
+			Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("3Gi")))
+			Expect(getRequest().Status).To(And(
+				HaveField("Phase", v1alpha1.ResizeRequestPhaseInProgress),
+				HaveField("Reason", v1alpha1.ResizeRequestReasonCapped),
+				HaveField("Message", "resizing from 1Gi to 3Gi, capped from the requested size 5Gi by the storage budget of namespace resize-request-test"),
+				HaveField("To", Equal(ptr.To(resource.MustParse("3Gi")))),
+			))
+		})
+
+		It("should report the capped size once the resize has succeeded", func() {
+			request.Status = v1alpha1.PersistentVolumeClaimResizeRequestStatus{
+				Phase:  v1alpha1.ResizeRequestPhaseInProgress,
+				Reason: v1alpha1.ResizeRequestReasonCapped,
+				To:     ptr.To(resource.MustParse("3Gi")),
+			}
+			Expect(fakeClient.Status().Update(ctx, request)).To(Succeed())
+			pvc.Status.Capacity[corev1.ResourceStorage] = resource.MustParse("3Gi")
+
+			Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)).To(BeFalse())
+			Expect(getRequest().Status).To(And(
+				HaveField("Phase", v1alpha1.ResizeRequestPhaseSucceeded),
+				HaveField("Reason", v1alpha1.ResizeRequestReasonCapped),
+				HaveField("Message", "PersistentVolumeClaim has been resized to 3Gi, capped from the requested size 5Gi"),
+			))
+		})
+
+		It("should fail without patching the PVC when there is no headroom left", func() {
+			setBudget("1Gi")
+
+			Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)).To(BeFalse())
+
+			var updatedPVC corev1.PersistentVolumeClaim
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), &updatedPVC)).To(Succeed())
+			Expect(updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("1Gi")))
+			Expect(getRequest().Status).To(And(
+				HaveField("Phase", v1alpha1.ResizeRequestPhaseFailed),
+				HaveField("Reason", v1alpha1.ResizeRequestReasonQuotaExceeded),
+				HaveField("Message", "resizing to 5Gi would exceed the storage budget of namespace resize-request-test, which has no headroom left"),
+			))
+		})
+	})
+
+	It("should fail when the requested size is not larger than the current size", func() {
+		request.Spec.Size = resource.MustParse("1Gi")
+
+		Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)).To(BeFalse())
+		Expect(getRequest().Status.Phase).To(Equal(v1alpha1.ResizeRequestPhaseFailed))
+	})
+
+	It("should wait while resizing is paused", func() {
+		Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, true, false, &recommendation, resizingConditions)).To(BeFalse())
+		Expect(getRequest().Status).To(And(
+			HaveField("Phase", v1alpha1.ResizeRequestPhasePending),
+			HaveField("Message", "resizing of the PersistentVolumeClaim is suspended or paused"),
+		))
+	})
+
+	It("should wait while another resize is in progress", func() {
+		Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, true, &recommendation, resizingConditions)).To(BeFalse())
+		Expect(getRequest().Status.Phase).To(Equal(v1alpha1.ResizeRequestPhasePending))
+	})
+
+	It("should succeed once the capacity has reached the new size", func() {
+		request.Status = v1alpha1.PersistentVolumeClaimResizeRequestStatus{
+			Phase: v1alpha1.ResizeRequestPhaseInProgress,
+			To:    ptr.To(resource.MustParse("5Gi")),
+		}
+		pvc.Status.Capacity[corev1.ResourceStorage] = resource.MustParse("5Gi")
+
+		Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, false, &recommendation, resizingConditions)).To(BeFalse())
+		Expect(getRequest().Status).To(And(
+			HaveField("Phase", v1alpha1.ResizeRequestPhaseSucceeded),
+			HaveField("CompletionTime", Not(BeNil())),
+		))
+	})
+
+	It("should not complete a request while the resize is in progress", func() {
+		request.Status = v1alpha1.PersistentVolumeClaimResizeRequestStatus{
+			Phase: v1alpha1.ResizeRequestPhaseInProgress,
+			To:    ptr.To(resource.MustParse("5Gi")),
+		}
+
+		Expect(runner.reconcileResizeRequest(ctx, logr.Discard(), request, pvc, policy, false, true, &recommendation, resizingConditions)).To(BeFalse())
+		Expect(request.Status.Phase).To(Equal(v1alpha1.ResizeRequestPhaseInProgress))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
+
+var _ = Describe("reportUnmanagedResizeRequests", func() {
+	const namespace = "resize-request-test"
+
+	It("should report new requests for unmanaged PVCs as not managed", func() {
+		ctx := context.Background()
+		newRequest := func(name, claimName string, phase v1alpha1.ResizeRequestPhase) *v1alpha1.PersistentVolumeClaimResizeRequest {
+			return &v1alpha1.PersistentVolumeClaimResizeRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec:       v1alpha1.PersistentVolumeClaimResizeRequestSpec{ClaimName: claimName, Size: resource.MustParse("5Gi"), Reason: "test"},
+				Status:     v1alpha1.PersistentVolumeClaimResizeRequestStatus{Phase: phase},
+			}
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				newRequest("managed", "managed-pvc", ""),
+				newRequest("unmanaged", "unmanaged-pvc", ""),
+				newRequest("processed", "unmanaged-pvc", v1alpha1.ResizeRequestPhasePending),
+			).
+			WithStatusSubresource(&v1alpha1.PersistentVolumeClaimResizeRequest{}).
+			Build()
+		runner := &Runner{
+			client:         fakeClient,
+			eventRecorder:  record.NewFakeRecorder(10),
+			pvcIndex:       newPVCIndex(),
+			resizeRequests: newResizeRequestTracker(fakeClient),
+		}
+		runner.pvcIndex.update(client.ObjectKey{Namespace: namespace, Name: "pvca"}, []*corev1.PersistentVolumeClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: "managed-pvc", Namespace: namespace}},
+		})
+		Expect(runner.resizeRequests.reset(ctx)).To(Succeed())
+
+		runner.reportUnmanagedResizeRequests(ctx, logr.Discard())
+
+		getStatus := func(name string) v1alpha1.PersistentVolumeClaimResizeRequestStatus {
+			var request v1alpha1.PersistentVolumeClaimResizeRequest
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &request)).To(Succeed())
+
+			return request.Status
+		}
+		Expect(getStatus("unmanaged")).To(And(
+			HaveField("Phase", v1alpha1.ResizeRequestPhasePending),
+			HaveField("Reason", v1alpha1.ResizeRequestReasonNotManaged),
+			HaveField("Message", "PersistentVolumeClaim unmanaged-pvc is not managed by any autoscaler"),
+		))
+		Expect(getStatus("managed").Phase).To(BeEmpty())
+		Expect(getStatus("processed").Reason).To(BeEmpty())
+	})
+})
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// schedule is a priority queue of the next checks of the autoscalers, ordered
+// by the time at which they are due. The key of a
+// [v1alpha1.ClusterPersistentVolumeClaimAutoscaler] has an empty namespace.
+type schedule struct {
+	mu    sync.Mutex
+	items scheduledChecks
+	index map[client.ObjectKey]*scheduledCheck
+}
+
+// scheduledCheck is the next check of a single autoscaler.
+type scheduledCheck struct {
+	key client.ObjectKey
+	at  time.Time
+	pos int
+}
+
+// scheduledChecks implements [heap.Interface] for the [scheduledCheck] items.
+type scheduledChecks []*scheduledCheck
+
+func (s scheduledChecks) Len() int { return len(s) }
+
+func (s scheduledChecks) Less(i, j int) bool { return s[i].at.Before(s[j].at) }
+
+func (s scheduledChecks) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+	s[i].pos = i
+	s[j].pos = j
+}
+
+func (s *scheduledChecks) Push(x any) {
+	item := x.(*scheduledCheck)
+	item.pos = len(*s)
+	*s = append(*s, item)
+}
+
+func (s *scheduledChecks) Pop() any {
+	old := *s
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*s = old[:n-1]
+
+	return item
+}
+
+// newSchedule creates a new empty [schedule].
+func newSchedule() *schedule {
+	return &schedule{index: make(map[client.ObjectKey]*scheduledCheck)}
+}
+
+// set schedules the next check of the autoscaler with the given key at the
+// given time, replacing any check which has been scheduled before.
+func (s *schedule) set(key client.ObjectKey, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if item, ok := s.index[key]; ok {
+		item.at = at
+		heap.Fix(&s.items, item.pos)
+
+		return
+	}
+
+	item := &scheduledCheck{key: key, at: at}
+	heap.Push(&s.items, item)
+	s.index[key] = item
+}
+
+// remove removes the check of the autoscaler with the given key.
+func (s *schedule) remove(key client.ObjectKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.index[key]
+	if !ok {
+		return
+	}
+
+	heap.Remove(&s.items, item.pos)
+	delete(s.index, key)
+}
+
+// get returns the time of the next check of the autoscaler with the given
+// key.
+func (s *schedule) get(key client.ObjectKey) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.index[key]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return item.at, true
+}
+
+// next returns the time of the earliest scheduled check.
+func (s *schedule) next() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.items) == 0 {
+		return time.Time{}, false
+	}
+
+	return s.items[0].at, true
+}
+
+// keys returns the keys of all autoscalers with a scheduled check.
+func (s *schedule) keys() []client.ObjectKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]client.ObjectKey, 0, len(s.items))
+	for _, item := range s.items {
+		keys = append(keys, item.key)
+	}
+
+	return keys
+}
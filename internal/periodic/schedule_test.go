@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+var _ = Describe("schedule", func() {
+	var (
+		s   *schedule
+		now time.Time
+
+		keyA = client.ObjectKey{Namespace: "default", Name: "a"}
+		keyB = client.ObjectKey{Namespace: "default", Name: "b"}
+		keyC = client.ObjectKey{Name: "c"}
+	)
+
+	BeforeEach(func() {
+		s = newSchedule()
+		now = time.Now()
+
+		s.set(keyA, now.Add(time.Hour))
+		s.set(keyB, now.Add(30*time.Second))
+		s.set(keyC, now.Add(time.Minute))
+	})
+
+	It("should return the earliest scheduled check", func() {
+		next, ok := s.next()
+		Expect(ok).To(BeTrue())
+		Expect(next).To(Equal(now.Add(30 * time.Second)))
+	})
+
+	It("should reschedule an existing check", func() {
+		s.set(keyA, now)
+
+		at, ok := s.get(keyA)
+		Expect(ok).To(BeTrue())
+		Expect(at).To(Equal(now))
+
+		next, _ := s.next()
+		Expect(next).To(Equal(now))
+		Expect(s.keys()).To(ConsistOf(keyA, keyB, keyC))
+	})
+
+	It("should remove a check", func() {
+		s.remove(keyB)
+		s.remove(client.ObjectKey{Name: "unknown"})
+
+		_, ok := s.get(keyB)
+		Expect(ok).To(BeFalse())
+
+		next, _ := s.next()
+		Expect(next).To(Equal(now.Add(time.Minute)))
+		Expect(s.keys()).To(ConsistOf(keyA, keyC))
+	})
+
+	It("should not return a check when empty", func() {
+		for _, key := range s.keys() {
+			s.remove(key)
+		}
+
+		_, ok := s.next()
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("#checkInterval", func() {
+	r := &Runner{interval: time.Minute}
+
+	DescribeTable("should return the interval of the autoscaler",
+		func(pvca v1alpha1.Autoscaler, expected time.Duration) {
+			Expect(r.checkInterval(pvca)).To(Equal(expected))
+		},
+		Entry("PVCA with a check interval",
+			&v1alpha1.PersistentVolumeClaimAutoscaler{Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{CheckInterval: &metav1.Duration{Duration: 30 * time.Second}}},
+			30*time.Second),
+		Entry("PVCA without a check interval",
+			&v1alpha1.PersistentVolumeClaimAutoscaler{},
+			time.Minute),
+		Entry("PVCA with an invalid check interval",
+			&v1alpha1.PersistentVolumeClaimAutoscaler{Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{CheckInterval: &metav1.Duration{}}},
+			time.Minute),
+		Entry("PVCA with a check interval below the minimum",
+			&v1alpha1.PersistentVolumeClaimAutoscaler{Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{CheckInterval: &metav1.Duration{Duration: time.Second}}},
+			time.Minute),
+		Entry("CPVCA",
+			&v1alpha1.ClusterPersistentVolumeClaimAutoscaler{},
+			time.Minute),
+	)
+})
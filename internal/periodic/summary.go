@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+// statusSummary summarizes the PVCs of a [v1alpha1.Autoscaler] within a single
+// reconciliation cycle.
+type statusSummary struct {
+	// reconcileTime is the time at which the PVCs have been checked. It is
+	// nil, when the PVCs could not be reconciled or have only been reconciled
+	// in response to an event, so that the status is not patched when
+	// nothing else has changed.
+	reconcileTime *metav1.Time
+
+	managedPVCs           int
+	healthyPVCs           int
+	resizingPVCs          int
+	maxUtilizationPercent *int
+}
+
+// summarize returns the [statusSummary] of the given PVCs, based on the
+// conditions, which have been added for them to the aggregators, and on their
+// volume recommendations. A PVC is healthy when a recommendation is available
+// for it and its resizing state is known. The reconcileTime may be nil, see
+// [statusSummary].
+func summarize(
+	reconcileTime *metav1.Time,
+	pvcs []*corev1.PersistentVolumeClaim,
+	volumeRecommendations []v1alpha1.VolumeRecommendation,
+	recommendationConditions *recommendationsConditionAggregator,
+	resizingConditions *resizingConditionAggregator,
+) statusSummary {
+	summary := statusSummary{
+		reconcileTime: reconcileTime,
+		managedPVCs:   len(pvcs),
+	}
+
+	for _, pvc := range pvcs {
+		key := client.ObjectKeyFromObject(pvc)
+		resizingCondition, hasResizingCondition := resizingConditions.pvcConditions[key]
+		if hasResizingCondition && resizingCondition.Status == metav1.ConditionTrue {
+			summary.resizingPVCs++
+		}
+
+		recommendationCondition, hasRecommendationCondition := recommendationConditions.pvcConditions[key]
+		if !hasRecommendationCondition || recommendationCondition.Status != metav1.ConditionTrue {
+			continue
+		}
+		if hasResizingCondition && resizingCondition.Status == metav1.ConditionUnknown {
+			continue
+		}
+		summary.healthyPVCs++
+	}
+
+	for _, volumeRecommendation := range volumeRecommendations {
+		for _, percent := range []*int{volumeRecommendation.Current.UsedSpacePercent, volumeRecommendation.Current.UsedInodesPercent} {
+			if percent != nil && (summary.maxUtilizationPercent == nil || *percent > *summary.maxUtilizationPercent) {
+				summary.maxUtilizationPercent = ptr.To(*percent)
+			}
+		}
+	}
+
+	return summary
+}
+
+// apply sets the summary on the status of an autoscaler. The time of the last
+// reconciliation is kept, when the summary has no reconcile time.
+func (s statusSummary) apply(status *v1alpha1.PersistentVolumeClaimAutoscalerStatus) {
+	if s.reconcileTime != nil {
+		status.LastReconcileTime = s.reconcileTime
+	}
+	status.ManagedPVCs = s.managedPVCs
+	status.HealthyPVCs = s.healthyPVCs
+	status.ResizingPVCs = s.resizingPVCs
+	status.MaxUtilizationPercent = s.maxUtilizationPercent
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package periodic
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+)
+
+var _ = Describe("summarize", func() {
+	var (
+		now                      metav1.Time
+		pvcs                     []*corev1.PersistentVolumeClaim
+		recommendationConditions *recommendationsConditionAggregator
+		resizingConditions       *resizingConditionAggregator
+	)
+
+	newPVC := func(name string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+
+	recommendationAvailable := func(pvc *corev1.PersistentVolumeClaim, status metav1.ConditionStatus) {
+		recommendationConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeRecommendationAvailable),
+			Status:  status,
+			Message: "test",
+		})
+	}
+
+	resizing := func(pvc *corev1.PersistentVolumeClaim, status metav1.ConditionStatus) {
+		resizingConditions.addPVCCondition(pvc, metav1.Condition{
+			Type:    string(v1alpha1.ConditionTypeResizing),
+			Status:  status,
+			Message: "test",
+		})
+	}
+
+	BeforeEach(func() {
+		now = metav1.Now()
+		pvcs = []*corev1.PersistentVolumeClaim{newPVC("pvc-a"), newPVC("pvc-b"), newPVC("pvc-c")}
+		recommendationConditions = &recommendationsConditionAggregator{}
+		resizingConditions = &resizingConditionAggregator{}
+	})
+
+	It("should count the managed, healthy and resizing PVCs", func() {
+		recommendationAvailable(pvcs[0], metav1.ConditionTrue)
+		resizing(pvcs[0], metav1.ConditionTrue)
+		recommendationAvailable(pvcs[1], metav1.ConditionTrue)
+		resizing(pvcs[1], metav1.ConditionUnknown)
+		recommendationAvailable(pvcs[2], metav1.ConditionFalse)
+
+		summary := summarize(&now, pvcs, nil, recommendationConditions, resizingConditions)
+		Expect(summary.reconcileTime).To(Equal(&now))
+		Expect(summary.managedPVCs).To(Equal(3))
+		Expect(summary.healthyPVCs).To(Equal(1))
+		Expect(summary.resizingPVCs).To(Equal(1))
+		Expect(summary.maxUtilizationPercent).To(BeNil())
+	})
+
+	It("should return the highest used space or inodes percentage", func() {
+		volumeRecommendations := []v1alpha1.VolumeRecommendation{
+			{Name: "pvc-a", Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(40), UsedInodesPercent: ptr.To(90)}},
+			{Name: "pvc-b", Current: v1alpha1.CurrentVolumeStatus{UsedSpacePercent: ptr.To(70)}},
+			{Name: "pvc-c"},
+		}
+
+		summary := summarize(&now, pvcs, volumeRecommendations, recommendationConditions, resizingConditions)
+		Expect(summary.maxUtilizationPercent).To(Equal(ptr.To(90)))
+	})
+})
+
+var _ = Describe("statusSummary", func() {
+	It("should keep the last reconcile time when the PVCs could not be reconciled", func() {
+		lastReconcileTime := metav1.Now()
+		status := v1alpha1.PersistentVolumeClaimAutoscalerStatus{
+			LastReconcileTime:     &lastReconcileTime,
+			ManagedPVCs:           2,
+			MaxUtilizationPercent: ptr.To(50),
+		}
+
+		statusSummary{}.apply(&status)
+		Expect(status.LastReconcileTime).To(Equal(&lastReconcileTime))
+		Expect(status.ManagedPVCs).To(BeZero())
+		Expect(status.MaxUtilizationPercent).To(BeNil())
+	})
+})
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pvcfetcher
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxOwnerDepth is the max number of owner references, which are followed from
+// a pod back to its target, e.g. Pod -> Job -> CronJob.
+const maxOwnerDepth = 5
+
+// fetchOwnedPods returns the pods in the namespace, which are the target
+// itself or which are owned by the target, directly or via intermediate
+// owners.
+func (f *pvcFetcher) fetchOwnedPods(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) ([]corev1.Pod, error) {
+	target := &metav1.PartialObjectMetadata{}
+	target.SetGroupVersionKind(schema.FromAPIVersionAndKind(targetRef.APIVersion, targetRef.Kind))
+	if err := f.apiReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: targetRef.Name}, target); err != nil {
+		return nil, fmt.Errorf("failed to get target %s: %w", targetRef.String(), err)
+	}
+
+	podList := &corev1.PodList{}
+	if err := f.client.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Pods: %w", err)
+	}
+
+	// Remembers for every visited object, whether it is owned by the target
+	owned := map[types.UID]bool{target.UID: true}
+	pods := make([]corev1.Pod, 0)
+	for i := range podList.Items {
+		isOwned, err := f.isOwnedBy(ctx, namespace, &podList.Items[i], owned, maxOwnerDepth)
+		if err != nil {
+			return nil, err
+		}
+
+		if isOwned {
+			pods = append(pods, podList.Items[i])
+		}
+	}
+
+	return pods, nil
+}
+
+// isOwnedBy returns whether the object has been marked as owned, or whether
+// one of its owners is owned, up to the given depth. Owners which do not exist
+// or whose kind is not served are not owned.
+func (f *pvcFetcher) isOwnedBy(ctx context.Context, namespace string, obj metav1.Object, owned map[types.UID]bool, depth int) (bool, error) {
+	uid := obj.GetUID()
+	if isOwned, ok := owned[uid]; ok && uid != "" {
+		return isOwned, nil
+	}
+
+	if depth == 0 {
+		return false, nil
+	}
+
+	result := false
+	for _, ownerRef := range obj.GetOwnerReferences() {
+		isOwned, ok := owned[ownerRef.UID]
+		if !ok {
+			owner := &metav1.PartialObjectMetadata{}
+			owner.SetGroupVersionKind(schema.FromAPIVersionAndKind(ownerRef.APIVersion, ownerRef.Kind))
+			if err := f.apiReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ownerRef.Name}, owner); err != nil {
+				if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+					return false, fmt.Errorf("failed to get owner %s %s of %s: %w", ownerRef.Kind, ownerRef.Name, obj.GetName(), err)
+				}
+
+				owned[ownerRef.UID] = false
+
+				continue
+			}
+
+			var err error
+			if isOwned, err = f.isOwnedBy(ctx, namespace, owner, owned, depth-1); err != nil {
+				return false, err
+			}
+		}
+
+		if isOwned {
+			result = true
+
+			break
+		}
+	}
+
+	if uid != "" {
+		owned[uid] = result
+	}
+
+	return result, nil
+}
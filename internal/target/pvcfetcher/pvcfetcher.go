@@ -8,9 +8,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
@@ -27,19 +36,62 @@ var (
 	// ErrNoPodsFound is returned when no pods match the target's selector.
 	ErrNoPodsFound = errors.New("no matching pods found for PersistentVolumeClaimAutoscaler")
 
-	// ErrNoPVCsFound is returned when pods are found but none of them have PVC volumes.
+	// ErrNoPVCsFound is returned when pods are found but none of them have PVC
+	// or generic ephemeral volumes.
 	ErrNoPVCsFound = errors.New("matched pods do not reference any PersistentVolumeClaims")
+
+	// ErrNoSelectedPVCsFound is returned when no PVCs match the selectors of a cluster-scoped autoscaler.
+	ErrNoSelectedPVCsFound = errors.New("no PersistentVolumeClaims match the selectors of ClusterPersistentVolumeClaimAutoscaler")
+
+	// ErrNoTargetsFound is returned when no workload controllers match the targetSelector.
+	ErrNoTargetsFound = errors.New("no targets match the targetSelector of PersistentVolumeClaimAutoscaler")
 )
 
+// Target is a workload controller targeted by a PersistentVolumeClaimAutoscaler
+// together with the PersistentVolumeClaims managed by it.
+type Target struct {
+	// Ref is the reference to the workload controller.
+	Ref autoscalingv1.CrossVersionObjectReference
+
+	// PVCs are the PersistentVolumeClaims managed by the workload controller.
+	PVCs []*corev1.PersistentVolumeClaim
+
+	// NotFound is set when the workload controller does not exist, in which
+	// case the target has no PersistentVolumeClaims.
+	NotFound bool
+}
+
 // Fetcher is an interface that can be used to fetch all PersistentVolumeClaims
 // that are managed by a PersistentVolumeClaimAutoscaler's targetRef.
 type Fetcher interface {
-	// Fetch returns all PersistentVolumeClaims that are managed by the given PersistentVolumeClaimAutoscaler's targetRef.
+	// Fetch returns all PersistentVolumeClaims that are managed by the given PersistentVolumeClaimAutoscaler's targets.
 	Fetch(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler) ([]*corev1.PersistentVolumeClaim, error)
+
+	// FetchTargets returns the targets of the given
+	// PersistentVolumeClaimAutoscaler, which are referenced by its targetRef
+	// or targetRefs or selected by its targetSelector, together with the
+	// PersistentVolumeClaims managed by them. Targets without
+	// PersistentVolumeClaims are left out, unless no target has any. Targets
+	// which do not exist are returned with NotFound set, so that the other
+	// targets are still managed.
+	FetchTargets(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler) ([]Target, error)
+
+	// FetchVolumeClaimTemplates returns a map of PersistentVolumeClaim names
+	// to the name of the StatefulSet volumeClaimTemplate they have been
+	// created from. PersistentVolumeClaims which have not been created from a
+	// volumeClaimTemplate are not part of the map. The map is empty, when
+	// none of the PersistentVolumeClaimAutoscaler's targets is a StatefulSet.
+	FetchVolumeClaimTemplates(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler, pvcs []*corev1.PersistentVolumeClaim) (map[string]string, error)
+
+	// FetchForCluster returns all PersistentVolumeClaims that are selected by
+	// the given ClusterPersistentVolumeClaimAutoscaler's namespace and
+	// PersistentVolumeClaim selectors.
+	FetchForCluster(ctx context.Context, cpvca *v1alpha1.ClusterPersistentVolumeClaimAutoscaler) ([]*corev1.PersistentVolumeClaim, error)
 }
 
 type pvcFetcher struct {
 	client          client.Client
+	apiReader       client.Reader
 	selectorFetcher selectorfetcher.Fetcher
 }
 
@@ -61,6 +113,10 @@ func New(opts ...Option) (Fetcher, error) {
 		return nil, ErrNoSelectorFetcher
 	}
 
+	if f.apiReader == nil {
+		f.apiReader = f.client
+	}
+
 	return f, nil
 }
 
@@ -71,6 +127,17 @@ func WithClient(c client.Client) Option {
 	}
 }
 
+// WithAPIReader configures the [Fetcher] with the given reader, which is used
+// to get the targets and the owners of their pods. Any kind may be referenced
+// as target or owner, so these reads should not go through the cache of the
+// client, which would start an informer for every kind. Defaults to the
+// client.
+func WithAPIReader(r client.Reader) Option {
+	return func(f *pvcFetcher) {
+		f.apiReader = r
+	}
+}
+
 // WithSelectorFetcher configures the [Fetcher] with the given fetcher.
 func WithSelectorFetcher(sf selectorfetcher.Fetcher) Option {
 	return func(f *pvcFetcher) {
@@ -79,11 +146,120 @@ func WithSelectorFetcher(sf selectorfetcher.Fetcher) Option {
 }
 
 func (f *pvcFetcher) Fetch(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler) ([]*corev1.PersistentVolumeClaim, error) {
-	if pvca.Spec.TargetRef.Kind == "PersistentVolumeClaim" {
+	targets, err := f.FetchTargets(ctx, pvca)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multiple targets might manage the same PVC
+	seen := sets.New[client.ObjectKey]()
+	pvcs := make([]*corev1.PersistentVolumeClaim, 0)
+	for _, target := range targets {
+		for _, pvc := range target.PVCs {
+			key := client.ObjectKeyFromObject(pvc)
+			if seen.Has(key) {
+				continue
+			}
+
+			seen.Insert(key)
+			pvcs = append(pvcs, pvc)
+		}
+	}
+
+	return pvcs, nil
+}
+
+func (f *pvcFetcher) FetchTargets(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler) ([]Target, error) {
+	targetRefs, err := f.resolveTargetRefs(ctx, pvca)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		targets        []Target
+		missingTargets []Target
+		errs           []error
+	)
+	for _, targetRef := range targetRefs {
+		pvcs, err := f.fetchForTarget(ctx, pvca.Namespace, targetRef)
+		if apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+			missingTargets = append(missingTargets, Target{Ref: targetRef, NotFound: true})
+
+			continue
+		}
+		if errors.Is(err, ErrNoPodsFound) || errors.Is(err, ErrNoPVCsFound) {
+			errs = append(errs, err)
+
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, Target{Ref: targetRef, PVCs: pvcs})
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return append(targets, missingTargets...), nil
+}
+
+// resolveTargetRefs returns the references to the targets of the given
+// PersistentVolumeClaimAutoscaler. The targets selected by the targetSelector
+// are listed in the namespace of the PersistentVolumeClaimAutoscaler.
+func (f *pvcFetcher) resolveTargetRefs(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler) ([]autoscalingv1.CrossVersionObjectReference, error) {
+	targetSelector := pvca.Spec.TargetSelector
+	if targetSelector == nil {
+		return pvca.GetTargetRefs(), nil
+	}
+
+	gv, err := schema.ParseGroupVersion(targetSelector.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion of targetSelector: %w", err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&targetSelector.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid targetSelector: %w", err)
+	}
+
+	objList := &metav1.PartialObjectMetadataList{}
+	objList.SetGroupVersionKind(gv.WithKind(targetSelector.Kind + "List"))
+	if err := f.client.List(ctx, objList, &client.ListOptions{LabelSelector: selector, Namespace: pvca.Namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", targetSelector.Kind, err)
+	}
+
+	if len(objList.Items) == 0 {
+		return nil, ErrNoTargetsFound
+	}
+
+	targetRefs := make([]autoscalingv1.CrossVersionObjectReference, 0, len(objList.Items))
+	for _, obj := range objList.Items {
+		targetRefs = append(targetRefs, autoscalingv1.CrossVersionObjectReference{
+			APIVersion: targetSelector.APIVersion,
+			Kind:       targetSelector.Kind,
+			Name:       obj.Name,
+		})
+	}
+
+	slices.SortFunc(targetRefs, func(a, b autoscalingv1.CrossVersionObjectReference) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return targetRefs, nil
+}
+
+// fetchForTarget returns the PersistentVolumeClaims managed by the given
+// target in the given namespace.
+func (f *pvcFetcher) fetchForTarget(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) ([]*corev1.PersistentVolumeClaim, error) {
+	if targetRef.Kind == "PersistentVolumeClaim" {
 		pvc := &corev1.PersistentVolumeClaim{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      pvca.Spec.TargetRef.Name,
-				Namespace: pvca.Namespace,
+				Name:      targetRef.Name,
+				Namespace: namespace,
 			},
 		}
 
@@ -94,45 +270,127 @@ func (f *pvcFetcher) Fetch(ctx context.Context, pvca *v1alpha1.PersistentVolumeC
 		return []*corev1.PersistentVolumeClaim{pvc}, nil
 	}
 
-	selector, err := f.selectorFetcher.Fetch(ctx, pvca.Namespace, pvca.Spec.TargetRef)
+	pods, err := f.fetchPods(ctx, namespace, targetRef)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch selector for target %s: %w", pvca.Spec.TargetRef.String(), err)
+		return nil, err
 	}
 
-	podList := &corev1.PodList{}
-	if err := f.client.List(ctx, podList, &client.ListOptions{LabelSelector: selector, Namespace: pvca.Namespace}); err != nil {
-		return nil, fmt.Errorf("failed to list Pods: %w", err)
+	pvcs, err := f.getPVCsFromPods(ctx, pods)
+	if err != nil {
+		return nil, fmt.Errorf("could not get all PersistentVolumeClaims: %w", err)
 	}
 
-	if len(podList.Items) == 0 {
-		return nil, ErrNoPodsFound
-	}
+	// The PVCs of a StatefulSet outlive its pods, e.g. when it is scaled to
+	// zero or its pods are pending, so they are derived from its
+	// volumeClaimTemplates as well.
+	if isStatefulSet(targetRef) {
+		templatePVCs, err := f.getPVCsFromVolumeClaimTemplates(ctx, namespace, targetRef.Name)
+		if err != nil {
+			return nil, err
+		}
 
-	pvcs, err := f.getPVCsFromPods(ctx, podList.Items)
-	if err != nil {
-		return nil, fmt.Errorf("could not get all PersistentVolumeClaims: %w", err)
+		for _, pvc := range templatePVCs {
+			if !slices.ContainsFunc(pvcs, func(other *corev1.PersistentVolumeClaim) bool { return other.Name == pvc.Name }) {
+				pvcs = append(pvcs, pvc)
+			}
+		}
 	}
 
 	if len(pvcs) == 0 {
+		if len(pods) == 0 {
+			return nil, ErrNoPodsFound
+		}
+
 		return nil, ErrNoPVCsFound
 	}
 
 	return pvcs, nil
 }
 
+// isStatefulSet returns whether the given target is a StatefulSet.
+func isStatefulSet(targetRef autoscalingv1.CrossVersionObjectReference) bool {
+	gv, err := schema.ParseGroupVersion(targetRef.APIVersion)
+
+	return err == nil && gv.Group == appsv1.GroupName && targetRef.Kind == "StatefulSet"
+}
+
+// getPVCsFromVolumeClaimTemplates returns the PersistentVolumeClaims, which
+// have been created from the volumeClaimTemplates of the given StatefulSet.
+// Besides the PVCs of the current replicas, this includes the PVCs retained
+// from replicas which have been removed by a scale-down.
+func (f *pvcFetcher) getPVCsFromVolumeClaimTemplates(ctx context.Context, namespace, name string) ([]*corev1.PersistentVolumeClaim, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	statefulSetKey := client.ObjectKey{Namespace: namespace, Name: name}
+	if err := f.client.Get(ctx, statefulSetKey, statefulSet); err != nil {
+		// Without the StatefulSet, its PVCs can only be found via its pods
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get StatefulSet %s: %w", statefulSetKey, err)
+	}
+
+	if len(statefulSet.Spec.VolumeClaimTemplates) == 0 {
+		return nil, nil
+	}
+
+	// The StatefulSet controller labels the PVCs with the matchLabels of the
+	// StatefulSet selector, which rules out PVCs of other StatefulSets with an
+	// ambiguous name.
+	var matchLabels map[string]string
+	if statefulSet.Spec.Selector != nil {
+		matchLabels = statefulSet.Spec.Selector.MatchLabels
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := f.client.List(ctx, pvcList, &client.ListOptions{LabelSelector: labels.SelectorFromSet(matchLabels), Namespace: namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list PersistentVolumeClaims: %w", err)
+	}
+
+	pvcs := make([]*corev1.PersistentVolumeClaim, 0)
+	for i := range pvcList.Items {
+		if _, ok := volumeClaimTemplateOf(statefulSet, pvcList.Items[i].Name); ok {
+			pvcs = append(pvcs, &pvcList.Items[i])
+		}
+	}
+
+	return pvcs, nil
+}
+
+// fetchPods returns the pods managed by the target. When the label selector
+// of the target cannot be determined, the pods are found by following their
+// owner references back to the target instead.
+func (f *pvcFetcher) fetchPods(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) ([]corev1.Pod, error) {
+	selector, err := f.selectorFetcher.Fetch(ctx, namespace, targetRef)
+	if errors.Is(err, selectorfetcher.ErrNoSelector) {
+		return f.fetchOwnedPods(ctx, namespace, targetRef)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch selector for target %s: %w", targetRef.String(), err)
+	}
+
+	podList := &corev1.PodList{}
+	if err := f.client.List(ctx, podList, &client.ListOptions{LabelSelector: selector, Namespace: namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list Pods: %w", err)
+	}
+
+	return podList.Items, nil
+}
+
 func (f *pvcFetcher) getPVCsFromPods(ctx context.Context, pods []corev1.Pod) ([]*corev1.PersistentVolumeClaim, error) {
 	// Use a map to deduplicate PVCs (multiple pods might reference the same PVC)
 	pvcMap := make(map[string]*corev1.PersistentVolumeClaim)
 
 	for _, pod := range pods {
 		for _, volume := range pod.Spec.Volumes {
-			if volume.PersistentVolumeClaim == nil {
+			claimName, isEphemeral := claimNameForVolume(&pod, volume)
+			if claimName == "" {
 				continue
 			}
 
 			pvcKey := client.ObjectKey{
 				Namespace: pod.Namespace,
-				Name:      volume.PersistentVolumeClaim.ClaimName,
+				Name:      claimName,
 			}
 
 			if _, exists := pvcMap[pvcKey.String()]; exists {
@@ -141,9 +399,20 @@ func (f *pvcFetcher) getPVCsFromPods(ctx context.Context, pods []corev1.Pod) ([]
 
 			pvc := &corev1.PersistentVolumeClaim{}
 			if err := f.client.Get(ctx, pvcKey, pvc); err != nil {
+				// The PVC of an ephemeral volume is created after the pod
+				if isEphemeral && apierrors.IsNotFound(err) {
+					continue
+				}
+
 				return nil, fmt.Errorf("failed to get PersistentVolumeClaim %s referenced by Pod %s: %w", pvcKey, client.ObjectKeyFromObject(&pod), err)
 			}
 
+			// The PVC of an ephemeral volume might still belong to a deleted
+			// pod with the same name, in which case it is about to be removed.
+			if isEphemeral && !metav1.IsControlledBy(pvc, &pod) {
+				continue
+			}
+
 			pvcMap[pvcKey.String()] = pvc
 		}
 	}
@@ -155,3 +424,138 @@ func (f *pvcFetcher) getPVCsFromPods(ctx context.Context, pods []corev1.Pod) ([]
 
 	return pvcs, nil
 }
+
+// ClaimNames returns the names of the PersistentVolumeClaims used by the
+// volumes of the given pod, including the PersistentVolumeClaims of generic
+// ephemeral volumes.
+func ClaimNames(pod *corev1.Pod) []string {
+	names := make([]string, 0)
+	for _, volume := range pod.Spec.Volumes {
+		if claimName, _ := claimNameForVolume(pod, volume); claimName != "" {
+			names = append(names, claimName)
+		}
+	}
+
+	return names
+}
+
+// claimNameForVolume returns the name of the PersistentVolumeClaim used by the
+// given volume of the pod, and whether it is the PVC of a generic ephemeral
+// volume. The name is empty, when the volume does not use a PVC.
+func claimNameForVolume(pod *corev1.Pod, volume corev1.Volume) (string, bool) {
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		return volume.PersistentVolumeClaim.ClaimName, false
+	case volume.Ephemeral != nil:
+		// The PVC of a generic ephemeral volume is named <pod>-<volume>
+		return pod.Name + "-" + volume.Name, true
+	default:
+		return "", false
+	}
+}
+
+func (f *pvcFetcher) FetchVolumeClaimTemplates(ctx context.Context, pvca *v1alpha1.PersistentVolumeClaimAutoscaler, pvcs []*corev1.PersistentVolumeClaim) (map[string]string, error) {
+	templates := make(map[string]string)
+
+	targetRefs, err := f.resolveTargetRefs(ctx, pvca)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, targetRef := range targetRefs {
+		if _, err := schema.ParseGroupVersion(targetRef.APIVersion); err != nil {
+			return nil, fmt.Errorf("invalid apiVersion of target %s: %w", targetRef.String(), err)
+		}
+
+		if !isStatefulSet(targetRef) {
+			continue
+		}
+
+		statefulSet := &appsv1.StatefulSet{}
+		statefulSetKey := client.ObjectKey{Namespace: pvca.Namespace, Name: targetRef.Name}
+		if err := f.client.Get(ctx, statefulSetKey, statefulSet); err != nil {
+			return nil, fmt.Errorf("failed to get StatefulSet %s: %w", statefulSetKey, err)
+		}
+
+		addVolumeClaimTemplates(templates, statefulSet, pvcs)
+	}
+
+	return templates, nil
+}
+
+// addVolumeClaimTemplates adds the PersistentVolumeClaims, which have been
+// created from a volumeClaimTemplate of the given StatefulSet, to the map of
+// PersistentVolumeClaim names to volumeClaimTemplate names.
+func addVolumeClaimTemplates(templates map[string]string, statefulSet *appsv1.StatefulSet, pvcs []*corev1.PersistentVolumeClaim) {
+	for _, pvc := range pvcs {
+		if template, ok := volumeClaimTemplateOf(statefulSet, pvc.Name); ok {
+			templates[pvc.Name] = template
+		}
+	}
+}
+
+// volumeClaimTemplateOf returns the name of the volumeClaimTemplate of the
+// given StatefulSet, from which the PersistentVolumeClaim with the given name
+// has been created.
+func volumeClaimTemplateOf(statefulSet *appsv1.StatefulSet, pvcName string) (string, bool) {
+	// PersistentVolumeClaims created from a volumeClaimTemplate are named
+	// <template>-<statefulset>-<ordinal>
+	for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+		ordinal, found := strings.CutPrefix(pvcName, fmt.Sprintf("%s-%s-", template.Name, statefulSet.Name))
+		if !found {
+			continue
+		}
+
+		if _, err := strconv.ParseUint(ordinal, 10, 32); err == nil {
+			return template.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func (f *pvcFetcher) FetchForCluster(ctx context.Context, cpvca *v1alpha1.ClusterPersistentVolumeClaimAutoscaler) ([]*corev1.PersistentVolumeClaim, error) {
+	namespaceSelector, err := selectorOrEverything(cpvca.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+
+	pvcSelector, err := selectorOrEverything(cpvca.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := f.client.List(ctx, namespaceList, &client.ListOptions{LabelSelector: namespaceSelector}); err != nil {
+		return nil, fmt.Errorf("failed to list Namespaces: %w", err)
+	}
+
+	pvcs := make([]*corev1.PersistentVolumeClaim, 0)
+	for _, namespace := range namespaceList.Items {
+		pvcList := &corev1.PersistentVolumeClaimList{}
+		if err := f.client.List(ctx, pvcList, &client.ListOptions{LabelSelector: pvcSelector, Namespace: namespace.Name}); err != nil {
+			return nil, fmt.Errorf("failed to list PersistentVolumeClaims in namespace %s: %w", namespace.Name, err)
+		}
+
+		for i := range pvcList.Items {
+			pvcs = append(pvcs, &pvcList.Items[i])
+		}
+	}
+
+	if len(pvcs) == 0 {
+		return nil, ErrNoSelectedPVCsFound
+	}
+
+	return pvcs, nil
+}
+
+// selectorOrEverything converts the given label selector into a
+// [labels.Selector]. Unlike [metav1.LabelSelectorAsSelector], a nil selector
+// selects everything.
+func selectorOrEverything(selector *metav1.LabelSelector) (labels.Selector, error) {
+	if selector == nil {
+		return labels.Everything(), nil
+	}
+
+	return metav1.LabelSelectorAsSelector(selector)
+}
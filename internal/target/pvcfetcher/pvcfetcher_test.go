@@ -10,16 +10,24 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
 	"github.com/gardener/pvc-autoscaler/internal/target/pvcfetcher"
+	"github.com/gardener/pvc-autoscaler/internal/target/selectorfetcher"
 )
 
 var _ = Describe("PVCFetcher", func() {
@@ -35,6 +43,8 @@ var _ = Describe("PVCFetcher", func() {
 
 		scheme := runtime.NewScheme()
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
 		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
 
 		fakeClient = fake.NewClientBuilder().WithScheme(scheme).Build()
@@ -402,11 +412,623 @@ var _ = Describe("PVCFetcher", func() {
 			Expect(pvcs[0].Namespace).To(Equal("default"))
 		})
 	})
+
+	Describe("Fetch for generic ephemeral volumes", func() {
+		var (
+			fetcher pvcfetcher.Fetcher
+
+			pvca *v1alpha1.PersistentVolumeClaimAutoscaler
+		)
+
+		createPodWithEphemeralVolume := func(name string, uid types.UID) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: uid, Labels: map[string]string{"app": "test"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test", Image: "test"}},
+					Volumes: []corev1.Volume{{
+						Name: "scratch",
+						VolumeSource: corev1.VolumeSource{
+							Ephemeral: &corev1.EphemeralVolumeSource{
+								VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{},
+							},
+						},
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+		}
+
+		createEphemeralPVC := func(podName string, podUID types.UID) {
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+				Name:      podName + "-scratch",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "Pod", Name: podName, UID: podUID, Controller: ptr.To(true)},
+				},
+			}}
+			Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			selectorFetcher.selector = labels.SelectorFromSet(labels.Set{"app": "test"})
+
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvca", Namespace: "default"},
+				Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+				},
+			}
+		})
+
+		It("should return the PVCs generated for the ephemeral volumes", func() {
+			createPodWithEphemeralVolume("test-a", "uid-a")
+			createEphemeralPVC("test-a", "uid-a")
+			createPodWithEphemeralVolume("test-b", "uid-b")
+			createEphemeralPVC("test-b", "uid-b")
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcNames(pvcs)).To(ConsistOf("test-a-scratch", "test-b-scratch"))
+		})
+
+		It("should skip ephemeral volumes whose PVC has not been created yet", func() {
+			createPodWithEphemeralVolume("test-a", "uid-a")
+			createEphemeralPVC("test-a", "uid-a")
+			createPodWithEphemeralVolume("test-b", "uid-b")
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcNames(pvcs)).To(ConsistOf("test-a-scratch"))
+		})
+
+		It("should skip PVCs which still belong to an earlier pod with the same name", func() {
+			createPodWithEphemeralVolume("test-a", "uid-a-new")
+			createEphemeralPVC("test-a", "uid-a-old")
+
+			_, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).To(MatchError(pvcfetcher.ErrNoPVCsFound))
+		})
+	})
+
+	Describe("Fetch for StatefulSets", func() {
+		var (
+			fetcher pvcfetcher.Fetcher
+
+			pvca *v1alpha1.PersistentVolumeClaimAutoscaler
+		)
+
+		createPVC := func(name string, labels map[string]string) {
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+			Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			selectorFetcher.selector = labels.SelectorFromSet(labels.Set{"app": "web"})
+
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To[int32](0),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "logs"}},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, statefulSet)).To(Succeed())
+
+			createPVC("data-web-0", map[string]string{"app": "web"})
+			createPVC("logs-web-0", map[string]string{"app": "web"})
+			createPVC("data-web-3", map[string]string{"app": "web"})
+			createPVC("data-web-backup", map[string]string{"app": "web"})
+			createPVC("data-web-1", map[string]string{"app": "other"})
+
+			pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvca", Namespace: "default"},
+				Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web"},
+				},
+			}
+		})
+
+		It("should return the PVCs created from the volumeClaimTemplates when no pods are running", func() {
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcNames(pvcs)).To(ConsistOf("data-web-0", "logs-web-0", "data-web-3"))
+		})
+
+		It("should combine the PVCs of the pods with the PVCs created from the volumeClaimTemplates", func() {
+			createPVC("shared", nil)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default", Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test", Image: "test"}},
+					Volumes: []corev1.Volume{
+						{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-web-0"}}},
+						{Name: "shared", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "shared"}}},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcNames(pvcs)).To(ConsistOf("data-web-0", "shared", "logs-web-0", "data-web-3"))
+		})
+
+		It("should return ErrNoPodsFound when no PVCs have been created from the volumeClaimTemplates", func() {
+			pvca.Spec.TargetRef.Name = "empty"
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "default"},
+				Spec: appsv1.StatefulSetSpec{
+					Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": "empty"}},
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, statefulSet)).To(Succeed())
+
+			_, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).To(MatchError(pvcfetcher.ErrNoPodsFound))
+		})
+	})
+
+	Describe("Fetch for targets without a selector", func() {
+		var (
+			fetcher pvcfetcher.Fetcher
+
+			pvca *v1alpha1.PersistentVolumeClaimAutoscaler
+		)
+
+		createPodWithPVC := func(name string, ownerRefs ...metav1.OwnerReference) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", OwnerReferences: ownerRefs},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test", Image: "test"}},
+					Volumes: []corev1.Volume{{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+						},
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+			Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			selectorFetcher.err = selectorfetcher.ErrNoSelector
+
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default", UID: "cronjob-uid"}}
+			Expect(fakeClient.Create(ctx, cronJob)).To(Succeed())
+
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:      "backup-1",
+				Namespace: "default",
+				UID:       "job-uid",
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup", UID: "cronjob-uid"},
+				},
+			}}
+			Expect(fakeClient.Create(ctx, job)).To(Succeed())
+
+			createPodWithPVC("backup-1-abcde", metav1.OwnerReference{APIVersion: "batch/v1", Kind: "Job", Name: "backup-1", UID: "job-uid"})
+			createPodWithPVC("unrelated")
+			createPodWithPVC("orphaned", metav1.OwnerReference{APIVersion: "batch/v1", Kind: "Job", Name: "deleted", UID: "deleted-uid"})
+
+			pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvca", Namespace: "default"},
+				Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup"},
+				},
+			}
+		})
+
+		It("should return the PVCs of the pods owned by the target via intermediate owners", func() {
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(HaveLen(1))
+			Expect(pvcs[0].Name).To(Equal("backup-1-abcde"))
+		})
+
+		It("should return the PVCs of the pods directly owned by the target", func() {
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{APIVersion: "batch/v1", Kind: "Job", Name: "backup-1"}
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(HaveLen(1))
+			Expect(pvcs[0].Name).To(Equal("backup-1-abcde"))
+		})
+
+		It("should return ErrNoPodsFound when no pods are owned by the target", func() {
+			cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "default", UID: "idle-uid"}}
+			Expect(fakeClient.Create(ctx, cronJob)).To(Succeed())
+			pvca.Spec.TargetRef.Name = "idle"
+
+			_, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).To(MatchError(pvcfetcher.ErrNoPodsFound))
+		})
+
+		It("should return an error when the target does not exist", func() {
+			pvca.Spec.TargetRef.Name = "missing"
+
+			_, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get target"))
+		})
+
+		It("should get the target and the owners with the API reader", func() {
+			// The cached client cannot start an informer for the kinds of the
+			// target and the owners, as it is not allowed to list them.
+			cachedClient := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*metav1.PartialObjectMetadata); ok {
+						gvk := obj.GetObjectKind().GroupVersionKind()
+						return apierrors.NewForbidden(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, "", errors.New("cannot list resource"))
+					}
+
+					return c.Get(ctx, key, obj, opts...)
+				},
+			})
+
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(cachedClient),
+				pvcfetcher.WithAPIReader(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(HaveLen(1))
+			Expect(pvcs[0].Name).To(Equal("backup-1-abcde"))
+		})
+	})
+
+	Describe("FetchTargets", func() {
+		var (
+			fetcher pvcfetcher.Fetcher
+
+			pvca *v1alpha1.PersistentVolumeClaimAutoscaler
+		)
+
+		createStatefulSet := func(name string, labels map[string]string) {
+			statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+			Expect(fakeClient.Create(ctx, statefulSet)).To(Succeed())
+		}
+
+		createPodWithPVC := func(app, claimName string) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: "default", Labels: map[string]string{"app": app}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "test", Image: "test"}},
+					Volumes: []corev1.Volume{{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+						},
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: "default"}}
+			Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+		}
+
+		targetRef := func(name string) autoscalingv1.CrossVersionObjectReference {
+			return autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: name}
+		}
+
+		BeforeEach(func() {
+			selectorFetcher.selectors = map[string]labels.Selector{
+				"sts-a": labels.SelectorFromSet(labels.Set{"app": "a"}),
+				"sts-b": labels.SelectorFromSet(labels.Set{"app": "b"}),
+				"sts-c": labels.SelectorFromSet(labels.Set{"app": "c"}),
+			}
+
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvca", Namespace: "default"},
+			}
+
+			createPodWithPVC("a", "data-sts-a-0")
+			createPodWithPVC("b", "data-sts-b-0")
+		})
+
+		It("should return the PVCs of each target of the targetRefs", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{targetRef("sts-a"), targetRef("sts-b")}
+
+			targets, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(ConsistOf(
+				And(HaveField("Ref", targetRef("sts-a")), HaveField("PVCs", ConsistOf(HaveField("Name", "data-sts-a-0")))),
+				And(HaveField("Ref", targetRef("sts-b")), HaveField("PVCs", ConsistOf(HaveField("Name", "data-sts-b-0")))),
+			))
+		})
+
+		It("should return the PVCs of the targets matching the targetSelector", func() {
+			createStatefulSet("sts-a", map[string]string{"tier": "db"})
+			createStatefulSet("sts-b", map[string]string{"tier": "db"})
+			createStatefulSet("sts-c", map[string]string{"tier": "web"})
+			pvca.Spec.TargetSelector = &v1alpha1.TargetSelector{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Selector:   metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+			}
+
+			targets, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(HaveExactElements(HaveField("Ref", targetRef("sts-a")), HaveField("Ref", targetRef("sts-b"))))
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(ConsistOf(HaveField("Name", "data-sts-a-0"), HaveField("Name", "data-sts-b-0")))
+		})
+
+		It("should return ErrNoTargetsFound when no targets match the targetSelector", func() {
+			pvca.Spec.TargetSelector = &v1alpha1.TargetSelector{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Selector:   metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+			}
+
+			_, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(err).To(MatchError(pvcfetcher.ErrNoTargetsFound))
+		})
+
+		It("should leave out targets without pods", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{targetRef("sts-a"), targetRef("sts-c")}
+
+			targets, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(ConsistOf(HaveField("Ref", targetRef("sts-a"))))
+		})
+
+		It("should return missing targets as not found and keep the other targets", func() {
+			selectorFetcher.errs = map[string]error{
+				"sts-b": apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "sts-b"),
+			}
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{targetRef("sts-b"), targetRef("sts-a")}
+
+			targets, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(HaveExactElements(
+				And(HaveField("Ref", targetRef("sts-a")), HaveField("NotFound", BeFalse()), HaveField("PVCs", ConsistOf(HaveField("Name", "data-sts-a-0")))),
+				And(HaveField("Ref", targetRef("sts-b")), HaveField("NotFound", BeTrue()), HaveField("PVCs", BeEmpty())),
+			))
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(ConsistOf(HaveField("Name", "data-sts-a-0")))
+		})
+
+		It("should return an error when no target exists", func() {
+			selectorFetcher.errs = map[string]error{
+				"sts-a": apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "sts-a"),
+			}
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{targetRef("sts-a")}
+
+			_, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should return ErrNoPodsFound when no target has pods", func() {
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{targetRef("sts-c")}
+
+			_, err := fetcher.FetchTargets(ctx, pvca)
+			Expect(err).To(MatchError(pvcfetcher.ErrNoPodsFound))
+		})
+
+		It("should deduplicate PVCs managed by multiple targets", func() {
+			selectorFetcher.selectors["sts-b"] = labels.SelectorFromSet(labels.Set{"app": "a"})
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{targetRef("sts-a"), targetRef("sts-b")}
+
+			pvcs, err := fetcher.Fetch(ctx, pvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(ConsistOf(HaveField("Name", "data-sts-a-0")))
+		})
+	})
+
+	Describe("FetchVolumeClaimTemplates", func() {
+		var (
+			fetcher pvcfetcher.Fetcher
+
+			pvca *v1alpha1.PersistentVolumeClaimAutoscaler
+			pvcs []*corev1.PersistentVolumeClaim
+		)
+
+		BeforeEach(func() {
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pvca",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+					TargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind:       "StatefulSet",
+						Name:       "test-sts",
+						APIVersion: "apps/v1",
+					},
+				},
+			}
+
+			pvcs = []*corev1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data-test-sts-0", Namespace: "default"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "data-wal-test-sts-0", Namespace: "default"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "data-test-sts-backup", Namespace: "default"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"}},
+			}
+		})
+
+		It("should return an empty map when the target is not a StatefulSet", func() {
+			pvca.Spec.TargetRef.Kind = "Deployment"
+
+			templates, err := fetcher.FetchVolumeClaimTemplates(ctx, pvca, pvcs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(templates).To(BeEmpty())
+		})
+
+		It("should return an error when the StatefulSet does not exist", func() {
+			_, err := fetcher.FetchVolumeClaimTemplates(ctx, pvca, pvcs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get StatefulSet default/test-sts"))
+		})
+
+		It("should map the PVCs to the volumeClaimTemplates they have been created from", func() {
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-sts",
+					Namespace: "default",
+				},
+				Spec: appsv1.StatefulSetSpec{
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "data-wal"}},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, statefulSet)).To(Succeed())
+
+			templates, err := fetcher.FetchVolumeClaimTemplates(ctx, pvca, pvcs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(templates).To(Equal(map[string]string{
+				"data-test-sts-0":     "data",
+				"data-wal-test-sts-0": "data-wal",
+			}))
+		})
+		It("should map the PVCs of multiple StatefulSets", func() {
+			pvca.Spec.TargetRef = autoscalingv1.CrossVersionObjectReference{}
+			pvca.Spec.TargetRefs = []autoscalingv1.CrossVersionObjectReference{
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "test-sts"},
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "other-sts"},
+			}
+			for _, name := range []string{"test-sts", "other-sts"} {
+				Expect(fakeClient.Create(ctx, &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec: appsv1.StatefulSetSpec{
+						VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+					},
+				})).To(Succeed())
+			}
+			pvcs = append(pvcs, &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data-other-sts-1", Namespace: "default"}})
+
+			templates, err := fetcher.FetchVolumeClaimTemplates(ctx, pvca, pvcs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(templates).To(Equal(map[string]string{
+				"data-test-sts-0":  "data",
+				"data-other-sts-1": "data",
+			}))
+		})
+	})
+
+	Describe("FetchForCluster", func() {
+		var (
+			fetcher pvcfetcher.Fetcher
+
+			cpvca *v1alpha1.ClusterPersistentVolumeClaimAutoscaler
+		)
+
+		BeforeEach(func() {
+			var err error
+			fetcher, err = pvcfetcher.New(
+				pvcfetcher.WithClient(fakeClient),
+				pvcfetcher.WithSelectorFetcher(selectorFetcher),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			cpvca = &v1alpha1.ClusterPersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cpvca"},
+			}
+
+			for _, namespace := range []*corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Labels: map[string]string{"tenant": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b", Labels: map[string]string{"tenant": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "system"}},
+			} {
+				Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+			}
+
+			for _, pvc := range []*corev1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "tenant-a", Labels: map[string]string{"tier": "db"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "tenant-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "tenant-b", Labels: map[string]string{"tier": "db"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "system", Labels: map[string]string{"tier": "db"}}},
+			} {
+				Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+			}
+		})
+
+		It("should return all PVCs when no selectors are specified", func() {
+			pvcs, err := fetcher.FetchForCluster(ctx, cpvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(HaveLen(4))
+		})
+
+		It("should return the PVCs matching the namespace and PVC selectors", func() {
+			cpvca.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}}
+			cpvca.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}}
+
+			pvcs, err := fetcher.FetchForCluster(ctx, cpvca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs).To(ConsistOf(
+				HaveField("ObjectMeta", And(HaveField("Name", "data"), HaveField("Namespace", "tenant-a"))),
+				HaveField("ObjectMeta", And(HaveField("Name", "data"), HaveField("Namespace", "tenant-b"))),
+			))
+		})
+
+		It("should return ErrNoSelectedPVCsFound when no PVCs match", func() {
+			cpvca.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}
+
+			_, err := fetcher.FetchForCluster(ctx, cpvca)
+			Expect(err).To(MatchError(pvcfetcher.ErrNoSelectedPVCsFound))
+		})
+	})
 })
 
 type fakeSelectorFetcher struct {
-	selector labels.Selector
-	err      error
+	selector  labels.Selector
+	selectors map[string]labels.Selector
+	errs      map[string]error
+	err       error
 }
 
 func (s *fakeSelectorFetcher) Fetch(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error) {
@@ -414,5 +1036,22 @@ func (s *fakeSelectorFetcher) Fetch(ctx context.Context, namespace string, targe
 		return nil, s.err
 	}
 
+	if err, ok := s.errs[targetRef.Name]; ok {
+		return nil, err
+	}
+
+	if selector, ok := s.selectors[targetRef.Name]; ok {
+		return selector, nil
+	}
+
 	return s.selector, nil
 }
+
+func pvcNames(pvcs []*corev1.PersistentVolumeClaim) []string {
+	names := make([]string, 0, len(pvcs))
+	for _, pvc := range pvcs {
+		names = append(names, pvc.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package selectorfetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	scaleclient "k8s.io/client-go/scale"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// builtinSelectorPaths are the paths to the label selector of built-in kinds,
+// which do not necessarily implement the scale subresource.
+var builtinSelectorPaths = map[schema.GroupKind]string{
+	{Group: "apps", Kind: "DaemonSet"}:         "{.spec.selector}",
+	{Group: "apps", Kind: "Deployment"}:        "{.spec.selector}",
+	{Group: "apps", Kind: "ReplicaSet"}:        "{.spec.selector}",
+	{Group: "apps", Kind: "StatefulSet"}:       "{.spec.selector}",
+	{Group: "batch", Kind: "Job"}:              "{.spec.selector}",
+	{Group: "", Kind: "ReplicationController"}: "{.spec.selector}",
+}
+
+// scaleResolver is a [Resolver], which takes the label selector from the
+// scale subresource of the target.
+type scaleResolver struct {
+	scaleClient scaleclient.ScalesGetter
+	restMapper  apimeta.RESTMapper
+}
+
+func (r *scaleResolver) Resolve(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error) {
+	targetGK, err := groupKind(targetRef)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := r.restMapper.RESTMappings(targetGK)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine resource for scale target reference: %w", err)
+	}
+
+	scale, err := r.scaleForResourceMappings(ctx, namespace, targetRef.Name, mappings)
+	if err != nil {
+		return nil, fmt.Errorf("%w: could not get scale subresource for target %s: %w", ErrNoSelector, targetRef.String(), err)
+	}
+
+	// Some kinds implement the scale subresource without a selector
+	if scale.Status.Selector == "" {
+		return nil, fmt.Errorf("%w: scale subresource of target %s has no selector", ErrNoSelector, targetRef.String())
+	}
+
+	labelSelector, err := labels.Parse(scale.Status.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse label selector for target %s: %w", targetRef.String(), err)
+	}
+
+	return labelSelector, nil
+}
+
+func (r *scaleResolver) scaleForResourceMappings(ctx context.Context, namespace, name string, mappings []*apimeta.RESTMapping) (*autoscalingv1.Scale, error) {
+	// make sure we handle an empty set of mappings
+	if len(mappings) == 0 {
+		return nil, errors.New("unrecognized resource")
+	}
+
+	errs := []error{}
+	for _, mapping := range mappings {
+		targetGR := mapping.Resource.GroupResource()
+		scale, err := r.scaleClient.Scales(namespace).Get(ctx, targetGR, name, metav1.GetOptions{})
+		if err == nil {
+			return scale, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// pathResolver is a [Resolver], which reads the label selector of the target
+// from the field at the JSONPath configured for its kind.
+type pathResolver struct {
+	client client.Reader
+	paths  map[schema.GroupKind]*jsonpath.JSONPath
+}
+
+// newPathResolver creates a new [pathResolver] for the given JSONPath
+// expressions per kind.
+func newPathResolver(c client.Reader, paths map[schema.GroupKind]string) (*pathResolver, error) {
+	r := &pathResolver{
+		client: c,
+		paths:  make(map[schema.GroupKind]*jsonpath.JSONPath, len(paths)),
+	}
+
+	for gk, path := range paths {
+		parser := jsonpath.New(gk.String())
+		if err := parser.Parse(path); err != nil {
+			return nil, fmt.Errorf("invalid selector path %q for %s: %w", path, gk.String(), err)
+		}
+		r.paths[gk] = parser
+	}
+
+	return r, nil
+}
+
+func (r *pathResolver) Resolve(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error) {
+	targetGK, err := groupKind(targetRef)
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := r.paths[targetGK]
+	if !ok {
+		return nil, fmt.Errorf("%w: no selector path known for %s", ErrNoSelector, targetGK.String())
+	}
+
+	target := &unstructured.Unstructured{}
+	target.SetAPIVersion(targetRef.APIVersion)
+	target.SetKind(targetRef.Kind)
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: targetRef.Name}, target); err != nil {
+		return nil, fmt.Errorf("could not get target %s: %w", targetRef.String(), err)
+	}
+
+	results, err := path.FindResults(target.Object)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return nil, fmt.Errorf("%w: target %s has no selector at the selector path", ErrNoSelector, targetRef.String())
+	}
+
+	labelSelector, err := parseSelector(results[0][0].Interface())
+	if err != nil {
+		return nil, fmt.Errorf("could not parse label selector for target %s: %w", targetRef.String(), err)
+	}
+
+	// An empty selector would select all pods of the namespace
+	if labelSelector.Empty() {
+		return nil, fmt.Errorf("%w: selector of target %s is empty", ErrNoSelector, targetRef.String())
+	}
+
+	return labelSelector, nil
+}
+
+// parseSelector parses a label selector, which is either given as a string,
+// as a label selector object, or as a map of labels.
+func parseSelector(value any) (labels.Selector, error) {
+	switch selector := value.(type) {
+	case string:
+		return labels.Parse(selector)
+	case map[string]any:
+		_, hasMatchLabels := selector["matchLabels"]
+		_, hasMatchExpressions := selector["matchExpressions"]
+		if hasMatchLabels || hasMatchExpressions {
+			labelSelector := &metav1.LabelSelector{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selector, labelSelector); err != nil {
+				return nil, err
+			}
+
+			return metav1.LabelSelectorAsSelector(labelSelector)
+		}
+
+		set := make(labels.Set, len(selector))
+		for key, val := range selector {
+			str, ok := val.(string)
+			if !ok {
+				return nil, fmt.Errorf("value of label %s is not a string", key)
+			}
+			set[key] = str
+		}
+
+		return labels.ValidatedSelectorFromSet(set)
+	default:
+		return nil, fmt.Errorf("unsupported selector type %T", value)
+	}
+}
+
+// groupKind returns the group and kind of the target.
+func groupKind(targetRef autoscalingv1.CrossVersionObjectReference) (schema.GroupKind, error) {
+	targetGV, err := schema.ParseGroupVersion(targetRef.APIVersion)
+	if err != nil {
+		return schema.GroupKind{}, fmt.Errorf("invalid API version in target reference: %w", err)
+	}
+
+	return schema.GroupKind{Group: targetGV.Group, Kind: targetRef.Kind}, nil
+}
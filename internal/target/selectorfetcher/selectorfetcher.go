@@ -7,14 +7,13 @@ package selectorfetcher
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	scaleclient "k8s.io/client-go/scale"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
@@ -23,21 +22,40 @@ var (
 
 	// ErrNoRESTMapper is returned when the [Fetcher] is configured without a REST mapper.
 	ErrNoRESTMapper = errors.New("no REST mapper provided")
+
+	// ErrNoSelector is returned when the label selector of a target cannot be
+	// determined. A [Resolver] returns it, when the next one in the chain
+	// should be tried.
+	ErrNoSelector = errors.New("no label selector found for target")
 )
 
-// Fetcher is an interface that can be used to fetch the label selector from
-// the scale subresource of an autoscalingv1.CrossVersionObjectReference.
+// Fetcher is an interface that can be used to fetch the label selector of the
+// pods managed by an autoscalingv1.CrossVersionObjectReference.
 type Fetcher interface {
-	// Fetch returns the label selector from the scale subresource of the provided
+	// Fetch returns the label selector of the pods managed by the provided
 	// autoscalingv1.CrossVersionObjectReference in the provided namespace.
-	// If the provided autoscalingv1.CrossVersionObjectReference does not support
-	// a scale subresource, an error is returned.
+	// The selector is taken from the scale subresource of the target, from
+	// the spec.selector of known built-in kinds, or from the configured
+	// selector paths, in this order. If none of them provides a selector, an
+	// error wrapping [ErrNoSelector] is returned.
 	Fetch(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error)
 }
 
+// Resolver resolves the label selector of the pods managed by a target. It
+// returns an error wrapping [ErrNoSelector], when it cannot provide a selector
+// for the target.
+type Resolver interface {
+	// Resolve returns the label selector of the pods managed by the provided
+	// autoscalingv1.CrossVersionObjectReference in the provided namespace.
+	Resolve(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error)
+}
+
 type selectorFetcher struct {
-	scaleClient scaleclient.ScalesGetter
-	restMapper  apimeta.RESTMapper
+	scaleClient   scaleclient.ScalesGetter
+	restMapper    apimeta.RESTMapper
+	client        client.Reader
+	selectorPaths map[schema.GroupKind]string
+	resolvers     []Resolver
 }
 
 // Option is a function which configures the [Fetcher].
@@ -58,6 +76,22 @@ func New(opts ...Option) (Fetcher, error) {
 		return nil, ErrNoRESTMapper
 	}
 
+	chain := []Resolver{&scaleResolver{scaleClient: f.scaleClient, restMapper: f.restMapper}}
+	if f.client != nil {
+		builtin, err := newPathResolver(f.client, builtinSelectorPaths)
+		if err != nil {
+			return nil, err
+		}
+
+		configured, err := newPathResolver(f.client, f.selectorPaths)
+		if err != nil {
+			return nil, err
+		}
+
+		chain = append(chain, builtin, configured)
+	}
+	f.resolvers = append(chain, f.resolvers...)
+
 	return f, nil
 }
 
@@ -75,47 +109,42 @@ func WithRESTMapper(rm apimeta.RESTMapper) Option {
 	}
 }
 
-func (f *selectorFetcher) Fetch(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error) {
-	targetGV, err := schema.ParseGroupVersion(targetRef.APIVersion)
-	if err != nil {
-		return nil, fmt.Errorf("invalid API version in target reference: %w", err)
-	}
-
-	targetGK := schema.GroupKind{
-		Group: targetGV.Group,
-		Kind:  targetRef.Kind,
-	}
-
-	mappings, err := f.restMapper.RESTMappings(targetGK)
-	if err != nil {
-		return nil, fmt.Errorf("unable to determine resource for scale target reference: %w", err)
-	}
-
-	scale, err := f.scaleForResourceMappings(ctx, namespace, targetRef.Name, mappings)
-	if err != nil {
-		return nil, fmt.Errorf("could not get scale subresource for target %s: %w", targetRef.String(), err)
+// WithClient configures the [Fetcher] with the given client, which is used
+// for reading the selector of targets without a scale subresource. Without a
+// client, only the scale subresource is used.
+func WithClient(c client.Reader) Option {
+	return func(f *selectorFetcher) {
+		f.client = c
 	}
+}
 
-	labelSelector, err := labels.Parse(scale.Status.Selector)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse label selector for target %s: %w", targetRef.String(), err)
+// WithSelectorPaths configures the [Fetcher] with JSONPath expressions per
+// kind, which point to the label selector of the pods managed by targets of
+// that kind. The selector may either be a string or a label selector object.
+func WithSelectorPaths(paths map[schema.GroupKind]string) Option {
+	return func(f *selectorFetcher) {
+		f.selectorPaths = paths
 	}
-
-	return labelSelector, nil
 }
 
-func (f *selectorFetcher) scaleForResourceMappings(ctx context.Context, namespace, name string, mappings []*apimeta.RESTMapping) (*autoscalingv1.Scale, error) {
-	// make sure we handle an empty set of mappings
-	if len(mappings) == 0 {
-		return nil, errors.New("unrecognized resource")
+// WithResolvers configures the [Fetcher] with additional resolvers, which are
+// tried after the built-in ones.
+func WithResolvers(resolvers ...Resolver) Option {
+	return func(f *selectorFetcher) {
+		f.resolvers = append(f.resolvers, resolvers...)
 	}
+}
 
+func (f *selectorFetcher) Fetch(ctx context.Context, namespace string, targetRef autoscalingv1.CrossVersionObjectReference) (labels.Selector, error) {
 	errs := []error{}
-	for _, mapping := range mappings {
-		targetGR := mapping.Resource.GroupResource()
-		scale, err := f.scaleClient.Scales(namespace).Get(ctx, targetGR, name, metav1.GetOptions{})
+	for _, resolver := range f.resolvers {
+		selector, err := resolver.Resolve(ctx, namespace, targetRef)
 		if err == nil {
-			return scale, nil
+			return selector, nil
+		}
+
+		if !errors.Is(err, ErrNoSelector) {
+			return nil, err
 		}
 
 		errs = append(errs, err)
@@ -6,17 +6,22 @@ package selectorfetcher_test
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	scalefake "k8s.io/client-go/scale/fake"
 	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/pvc-autoscaler/internal/target/selectorfetcher"
 )
@@ -55,6 +60,17 @@ var _ = Describe("SelectorFetcher", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(fetcher).ToNot(BeNil())
 		})
+
+		It("should return an error when a selector path is invalid", func() {
+			_, err := selectorfetcher.New(
+				selectorfetcher.WithScaleClient(&scalefake.FakeScaleClient{}),
+				selectorfetcher.WithRESTMapper(meta.NewDefaultRESTMapper([]schema.GroupVersion{})),
+				selectorfetcher.WithClient(fake.NewClientBuilder().Build()),
+				selectorfetcher.WithSelectorPaths(map[schema.GroupKind]string{{Group: "example.com", Kind: "Database"}: "{.spec.selector"}),
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid selector path"))
+		})
 	})
 
 	Describe("Fetch", func() {
@@ -150,4 +166,86 @@ var _ = Describe("SelectorFetcher", func() {
 			})
 		})
 	})
+	Describe("Fetch for targets without a scale subresource", func() {
+		var (
+			scaleClient     *scalefake.FakeScaleClient
+			mapper          *meta.DefaultRESTMapper
+			selectorFetcher selectorfetcher.Fetcher
+
+			databaseGVK schema.GroupVersionKind
+		)
+
+		BeforeEach(func() {
+			databaseGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+
+			scaleClient = &scalefake.FakeScaleClient{}
+			scaleClient.AddReactor("get", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), "scale")
+			})
+
+			mapper = meta.NewDefaultRESTMapper([]schema.GroupVersion{appsv1.SchemeGroupVersion, databaseGVK.GroupVersion()})
+			mapper.Add(appsv1.SchemeGroupVersion.WithKind("DaemonSet"), meta.RESTScopeNamespace)
+			mapper.Add(databaseGVK, meta.RESTScopeNamespace)
+
+			scheme := runtime.NewScheme()
+			Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-ds", Namespace: "default"},
+				Spec: appsv1.DaemonSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test-ds"}},
+				},
+			}
+
+			database := &unstructured.Unstructured{}
+			database.SetGroupVersionKind(databaseGVK)
+			database.SetName("test-db")
+			database.SetNamespace("default")
+			Expect(unstructured.SetNestedField(database.Object, "cluster-name=test-db", "status", "labelSelector")).To(Succeed())
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(daemonSet, database).Build()
+
+			var err error
+			selectorFetcher, err = selectorfetcher.New(
+				selectorfetcher.WithScaleClient(scaleClient),
+				selectorfetcher.WithRESTMapper(mapper),
+				selectorfetcher.WithClient(c),
+				selectorfetcher.WithSelectorPaths(map[schema.GroupKind]string{databaseGVK.GroupKind(): "{.status.labelSelector}"}),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return the spec.selector of a built-in kind", func() {
+			targetRef := autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "test-ds"}
+
+			selector, err := selectorFetcher.Fetch(ctx, "default", targetRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector.Matches(labels.Set{"app": "test-ds"})).To(BeTrue())
+			Expect(selector.Matches(labels.Set{"app": "other"})).To(BeFalse())
+		})
+
+		It("should return the selector at the configured selector path", func() {
+			targetRef := autoscalingv1.CrossVersionObjectReference{APIVersion: "example.com/v1", Kind: "Database", Name: "test-db"}
+
+			selector, err := selectorFetcher.Fetch(ctx, "default", targetRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector.Matches(labels.Set{"cluster-name": "test-db"})).To(BeTrue())
+		})
+
+		It("should return ErrNoSelector when no resolver provides a selector", func() {
+			mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Cache"}, meta.RESTScopeNamespace)
+			targetRef := autoscalingv1.CrossVersionObjectReference{APIVersion: "example.com/v1", Kind: "Cache", Name: "test-cache"}
+
+			_, err := selectorFetcher.Fetch(ctx, "default", targetRef)
+			Expect(err).To(MatchError(selectorfetcher.ErrNoSelector))
+		})
+
+		It("should return an error when the target of a known kind does not exist", func() {
+			targetRef := autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "missing"}
+
+			_, err := selectorFetcher.Fetch(ctx, "default", targetRef)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, selectorfetcher.ErrNoSelector)).To(BeFalse())
+		})
+	})
 })
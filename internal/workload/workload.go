@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package workload provides a controller, which generates and owns
+// PersistentVolumeClaimAutoscalers for workloads that opt into autoscaling
+// via annotations.
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+)
+
+// ErrNoClient is an error which is returned when the [Generator] was
+// configured without a Kubernetes API client.
+var ErrNoClient = errors.New("no client provided")
+
+// ErrInvalidAnnotation is an error which is returned when a workload
+// specifies an invalid autoscaling policy annotation.
+var ErrInvalidAnnotation = errors.New("invalid annotation")
+
+// Event reasons reported on the annotated workloads.
+const (
+	// ReasonInvalidAnnotations indicates that the autoscaling annotations of a
+	// workload are invalid.
+	ReasonInvalidAnnotations = "InvalidAutoscalingAnnotations"
+	// ReasonPVCAConflict indicates that a PersistentVolumeClaimAutoscaler with
+	// the generated name exists, but is not owned by the workload.
+	ReasonPVCAConflict = "PersistentVolumeClaimAutoscalerConflict"
+)
+
+// supportedWorkloads maps the kinds of the workloads, which may be annotated
+// for autoscaling, to constructors of the respective objects.
+var supportedWorkloads = map[schema.GroupVersionKind]func() client.Object{
+	appsv1.SchemeGroupVersion.WithKind("StatefulSet"): func() client.Object { return &appsv1.StatefulSet{} },
+	appsv1.SchemeGroupVersion.WithKind("Deployment"):  func() client.Object { return &appsv1.Deployment{} },
+}
+
+// Generator creates and updates a PersistentVolumeClaimAutoscaler for each
+// workload annotated with [common.AnnotationPVCAutoscaler]. The generated
+// PersistentVolumeClaimAutoscaler is controlled by the workload, so it is
+// garbage collected together with it.
+type Generator struct {
+	client         client.Client
+	eventRecorder  record.EventRecorder
+	autoscalerName string
+}
+
+// Option is a function which configures the [Generator].
+type Option func(g *Generator)
+
+// New creates a new [Generator] with the given options.
+func New(opts ...Option) (*Generator, error) {
+	g := &Generator{}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	if g.client == nil {
+		return nil, ErrNoClient
+	}
+
+	if g.eventRecorder == nil {
+		return nil, common.ErrNoEventRecorder
+	}
+
+	return g, nil
+}
+
+// WithClient configures the [Generator] with the given client.
+func WithClient(c client.Client) Option {
+	opt := func(g *Generator) {
+		g.client = c
+	}
+
+	return opt
+}
+
+// WithEventRecorder configures the [Generator] to use the given event recorder.
+func WithEventRecorder(recorder record.EventRecorder) Option {
+	opt := func(g *Generator) {
+		g.eventRecorder = recorder
+	}
+
+	return opt
+}
+
+// WithAutoscalerName configures the [Generator] to set the given
+// autoscalerName on the generated PersistentVolumeClaimAutoscalers, so that
+// they are reconciled by the same autoscaler instance.
+func WithAutoscalerName(name string) Option {
+	opt := func(g *Generator) {
+		g.autoscalerName = name
+	}
+
+	return opt
+}
+
+// SetupWithManager registers a controller with the manager for each of the
+// supported workload kinds.
+func (g *Generator) SetupWithManager(mgr ctrl.Manager) error {
+	for gvk, newObject := range supportedWorkloads {
+		err := builder.ControllerManagedBy(mgr).
+			Named(strings.ToLower(gvk.Kind)+"-pvca-generator").
+			For(newObject(), builder.WithPredicates(predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			))).
+			Owns(&v1alpha1.PersistentVolumeClaimAutoscaler{}).
+			Complete(g.reconcilerFor(gvk, newObject))
+		if err != nil {
+			return fmt.Errorf("unable to set up controller for %s: %w", gvk.Kind, err)
+		}
+	}
+
+	return nil
+}
+
+// reconcilerFor returns a [reconcile.Reconciler] for the workloads of the
+// given kind.
+func (g *Generator) reconcilerFor(gvk schema.GroupVersionKind, newObject func() client.Object) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		workload := newObject()
+		if err := g.client.Get(ctx, req.NamespacedName, workload); err != nil {
+			// The generated PVCA is garbage collected with the workload
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+
+		return reconcile.Result{}, g.reconcile(ctx, gvk, workload)
+	})
+}
+
+// reconcile ensures that the PersistentVolumeClaimAutoscaler for the given
+// workload matches its annotations.
+func (g *Generator) reconcile(ctx context.Context, gvk schema.GroupVersionKind, workload client.Object) error {
+	logger := log.FromContext(ctx, "kind", gvk.Kind)
+
+	pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+	key := client.ObjectKey{Namespace: workload.GetNamespace(), Name: PVCAName(gvk.Kind, workload.GetName())}
+	if err := g.client.Get(ctx, key, pvca); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		pvca = nil
+	}
+
+	if pvca != nil && !metav1.IsControlledBy(pvca, workload) {
+		if workload.GetAnnotations()[common.AnnotationPVCAutoscaler] == common.AnnotationPVCAutoscalerEnabled {
+			g.eventRecorder.Eventf(
+				workload,
+				corev1.EventTypeWarning,
+				ReasonPVCAConflict,
+				"PersistentVolumeClaimAutoscaler %s exists and is not owned by the %s",
+				key.Name,
+				gvk.Kind,
+			)
+		}
+
+		return nil
+	}
+
+	if workload.GetAnnotations()[common.AnnotationPVCAutoscaler] != common.AnnotationPVCAutoscalerEnabled {
+		if pvca == nil {
+			return nil
+		}
+		logger.Info("deleting generated PersistentVolumeClaimAutoscaler", "pvca", key.Name)
+
+		return client.IgnoreNotFound(g.client.Delete(ctx, pvca))
+	}
+
+	policy, err := volumePolicyFromAnnotations(workload.GetAnnotations())
+	if err != nil {
+		g.eventRecorder.Event(workload, corev1.EventTypeWarning, ReasonInvalidAnnotations, err.Error())
+
+		// Retrying does not help until the annotations are fixed, which
+		// triggers a new reconciliation.
+		return nil
+	}
+
+	targetRef := autoscalingv1.CrossVersionObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       workload.GetName(),
+	}
+
+	if pvca == nil {
+		pvca = &v1alpha1.PersistentVolumeClaimAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+			},
+		}
+		setGeneratedSpec(&pvca.Spec, g.autoscalerName, targetRef, policy)
+		if err := controllerutil.SetControllerReference(workload, pvca, g.client.Scheme()); err != nil {
+			return err
+		}
+		logger.Info("creating PersistentVolumeClaimAutoscaler", "pvca", key.Name)
+
+		return g.client.Create(ctx, pvca)
+	}
+
+	// Only the fields derived from the annotations are owned by the
+	// generator, so that other fields, e.g. suspend, may still be changed
+	// on the generated PVCA.
+	spec := pvca.Spec.DeepCopy()
+	setGeneratedSpec(spec, g.autoscalerName, targetRef, policy)
+	if apiequality.Semantic.DeepEqual(pvca.Spec, *spec) {
+		return nil
+	}
+
+	patch := client.MergeFrom(pvca.DeepCopy())
+	pvca.Spec = *spec
+	logger.Info("updating PersistentVolumeClaimAutoscaler", "pvca", key.Name)
+
+	return g.client.Patch(ctx, pvca, patch)
+}
+
+// setGeneratedSpec sets the fields of the given spec, which are owned by the
+// generator.
+func setGeneratedSpec(
+	spec *v1alpha1.PersistentVolumeClaimAutoscalerSpec,
+	autoscalerName string,
+	targetRef autoscalingv1.CrossVersionObjectReference,
+	policy v1alpha1.VolumePolicy,
+) {
+	spec.AutoscalerName = autoscalerName
+	spec.TargetRef = targetRef
+	spec.VolumePolicies = []v1alpha1.VolumePolicy{policy}
+}
+
+// PVCAName returns the name of the PersistentVolumeClaimAutoscaler generated
+// for the workload of the given kind and name.
+func PVCAName(kind, name string) string {
+	return fmt.Sprintf("%s-%s", name, strings.ToLower(kind))
+}
+
+// volumePolicyFromAnnotations returns the volume policy for all PVCs of a
+// workload, which is configured by the given annotations. All fields, which
+// are defaulted by the API, are set explicitly, so that the generated spec
+// can be compared with the stored one.
+func volumePolicyFromAnnotations(annotations map[string]string) (v1alpha1.VolumePolicy, error) {
+	policy := v1alpha1.VolumePolicy{}
+	v1alpha1.SetDefaultsVolumePolicy(&policy)
+
+	maxCapacity, ok := annotations[common.AnnotationMaxCapacity]
+	if !ok {
+		return policy, fmt.Errorf("%w: %s is required", ErrInvalidAnnotation, common.AnnotationMaxCapacity)
+	}
+	quantity, err := resource.ParseQuantity(maxCapacity)
+	if err != nil {
+		return policy, fmt.Errorf("%w: %s: %w", ErrInvalidAnnotation, common.AnnotationMaxCapacity, err)
+	}
+	policy.MaxCapacity = quantity
+
+	if value, ok := annotations[common.AnnotationUtilizationThresholdPercent]; ok {
+		percent, err := parsePercent(value, 1)
+		if err != nil {
+			return policy, fmt.Errorf("%w: %s: %w", ErrInvalidAnnotation, common.AnnotationUtilizationThresholdPercent, err)
+		}
+		policy.ScaleUp.UtilizationThresholdPercent = ptr.To(percent)
+	}
+
+	if value, ok := annotations[common.AnnotationStepPercent]; ok {
+		percent, err := parsePercent(value, 5)
+		if err != nil {
+			return policy, fmt.Errorf("%w: %s: %w", ErrInvalidAnnotation, common.AnnotationStepPercent, err)
+		}
+		policy.ScaleUp.StepPercent = ptr.To(percent)
+	}
+
+	if value, ok := annotations[common.AnnotationMinStepAbsolute]; ok {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return policy, fmt.Errorf("%w: %s: %w", ErrInvalidAnnotation, common.AnnotationMinStepAbsolute, err)
+		}
+		policy.ScaleUp.MinStepAbsolute = ptr.To(quantity)
+	}
+
+	if value, ok := annotations[common.AnnotationCooldownDuration]; ok {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return policy, fmt.Errorf("%w: %s: %w", ErrInvalidAnnotation, common.AnnotationCooldownDuration, err)
+		}
+		policy.ScaleUp.CooldownDuration = &metav1.Duration{Duration: duration}
+	}
+
+	return policy, nil
+}
+
+// parsePercent parses the given percentage value, which has to be in the
+// range [minPercent, 100].
+func parsePercent(value string, minPercent int) (int, error) {
+	percent, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if percent < minPercent || percent > 100 {
+		return 0, fmt.Errorf("percentage %d is not in the range [%d, 100]", percent, minPercent)
+	}
+
+	return percent, nil
+}
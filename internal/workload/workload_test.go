@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workload
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/pvc-autoscaler/api/autoscaling/v1alpha1"
+	"github.com/gardener/pvc-autoscaler/internal/common"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		ctx context.Context
+
+		fakeClient    client.Client
+		eventRecorder *record.FakeRecorder
+		generator     *Generator
+		reconciler    reconcile.Reconciler
+
+		sts *appsv1.StatefulSet
+	)
+
+	statefulSetGVK := appsv1.SchemeGroupVersion.WithKind("StatefulSet")
+	pvcaKey := client.ObjectKey{Namespace: "default", Name: "test-sts-statefulset"}
+
+	reconcileStatefulSet := func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(sts)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+		eventRecorder = record.NewFakeRecorder(16)
+
+		var err error
+		generator, err = New(
+			WithClient(fakeClient),
+			WithEventRecorder(eventRecorder),
+			WithAutoscalerName("test-autoscaler"),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		reconciler = generator.reconcilerFor(statefulSetGVK, supportedWorkloads[statefulSetGVK])
+
+		sts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-sts",
+				Namespace: "default",
+				Annotations: map[string]string{
+					common.AnnotationPVCAutoscaler: common.AnnotationPVCAutoscalerEnabled,
+					common.AnnotationMaxCapacity:   "10Gi",
+				},
+			},
+		}
+		Expect(fakeClient.Create(ctx, sts)).To(Succeed())
+	})
+
+	Describe("New", func() {
+		It("should return an error when no client is provided", func() {
+			_, err := New(WithEventRecorder(eventRecorder))
+			Expect(err).To(Equal(ErrNoClient))
+		})
+
+		It("should return an error when no event recorder is provided", func() {
+			_, err := New(WithClient(fakeClient))
+			Expect(err).To(Equal(common.ErrNoEventRecorder))
+		})
+	})
+
+	Describe("Reconcile", func() {
+		It("should create a PVCA owned by the annotated workload", func() {
+			reconcileStatefulSet()
+
+			pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+			Expect(fakeClient.Get(ctx, pvcaKey, pvca)).To(Succeed())
+			Expect(metav1.IsControlledBy(pvca, sts)).To(BeTrue())
+			Expect(pvca.Spec.AutoscalerName).To(Equal("test-autoscaler"))
+			Expect(pvca.Spec.TargetRef.APIVersion).To(Equal("apps/v1"))
+			Expect(pvca.Spec.TargetRef.Kind).To(Equal("StatefulSet"))
+			Expect(pvca.Spec.TargetRef.Name).To(Equal("test-sts"))
+			Expect(pvca.Spec.VolumePolicies).To(HaveLen(1))
+			policy := pvca.Spec.VolumePolicies[0]
+			Expect(policy.Match.Name).To(Equal("*"))
+			Expect(policy.MaxCapacity.Equal(resource.MustParse("10Gi"))).To(BeTrue())
+			Expect(policy.ScaleUp.UtilizationThresholdPercent).To(Equal(ptr.To(common.DefaultThresholdPercent)))
+			Expect(policy.ScaleUp.StepPercent).To(Equal(ptr.To(common.DefaultStepPercent)))
+			Expect(policy.ScaleUp.ResizeStrategy).To(Equal(v1alpha1.InPlaceVolumeResizeStrategy))
+		})
+
+		It("should update the PVCA when the policy annotations change", func() {
+			reconcileStatefulSet()
+
+			sts.Annotations[common.AnnotationMaxCapacity] = "20Gi"
+			sts.Annotations[common.AnnotationUtilizationThresholdPercent] = "90"
+			sts.Annotations[common.AnnotationStepPercent] = "25"
+			sts.Annotations[common.AnnotationMinStepAbsolute] = "5Gi"
+			sts.Annotations[common.AnnotationCooldownDuration] = "1h"
+			Expect(fakeClient.Update(ctx, sts)).To(Succeed())
+			reconcileStatefulSet()
+
+			pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+			Expect(fakeClient.Get(ctx, pvcaKey, pvca)).To(Succeed())
+			policy := pvca.Spec.VolumePolicies[0]
+			Expect(policy.MaxCapacity.Equal(resource.MustParse("20Gi"))).To(BeTrue())
+			Expect(policy.ScaleUp.UtilizationThresholdPercent).To(Equal(ptr.To(90)))
+			Expect(policy.ScaleUp.StepPercent).To(Equal(ptr.To(25)))
+			Expect(policy.ScaleUp.MinStepAbsolute.Equal(resource.MustParse("5Gi"))).To(BeTrue())
+			Expect(policy.ScaleUp.CooldownDuration.Duration.String()).To(Equal("1h0m0s"))
+		})
+
+		It("should keep the fields of the PVCA which are not derived from the annotations", func() {
+			reconcileStatefulSet()
+
+			pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{}
+			Expect(fakeClient.Get(ctx, pvcaKey, pvca)).To(Succeed())
+			pvca.Spec.Suspend = true
+			Expect(fakeClient.Update(ctx, pvca)).To(Succeed())
+
+			sts.Annotations[common.AnnotationMaxCapacity] = "20Gi"
+			Expect(fakeClient.Update(ctx, sts)).To(Succeed())
+			reconcileStatefulSet()
+
+			Expect(fakeClient.Get(ctx, pvcaKey, pvca)).To(Succeed())
+			Expect(pvca.Spec.Suspend).To(BeTrue())
+			Expect(pvca.Spec.VolumePolicies[0].MaxCapacity.Equal(resource.MustParse("20Gi"))).To(BeTrue())
+		})
+
+		It("should delete the generated PVCA when autoscaling is disabled", func() {
+			reconcileStatefulSet()
+
+			delete(sts.Annotations, common.AnnotationPVCAutoscaler)
+			Expect(fakeClient.Update(ctx, sts)).To(Succeed())
+			reconcileStatefulSet()
+
+			err := fakeClient.Get(ctx, pvcaKey, &v1alpha1.PersistentVolumeClaimAutoscaler{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not create a PVCA when the annotations are invalid", func() {
+			sts.Annotations[common.AnnotationStepPercent] = "1"
+			Expect(fakeClient.Update(ctx, sts)).To(Succeed())
+			reconcileStatefulSet()
+
+			err := fakeClient.Get(ctx, pvcaKey, &v1alpha1.PersistentVolumeClaimAutoscaler{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(eventRecorder.Events).To(Receive(ContainSubstring(ReasonInvalidAnnotations)))
+		})
+
+		It("should not create a PVCA when the max capacity is missing", func() {
+			delete(sts.Annotations, common.AnnotationMaxCapacity)
+			Expect(fakeClient.Update(ctx, sts)).To(Succeed())
+			reconcileStatefulSet()
+
+			err := fakeClient.Get(ctx, pvcaKey, &v1alpha1.PersistentVolumeClaimAutoscaler{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(eventRecorder.Events).To(Receive(ContainSubstring(common.AnnotationMaxCapacity)))
+		})
+
+		It("should leave a PVCA which is not owned by the workload untouched", func() {
+			pvca := &v1alpha1.PersistentVolumeClaimAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: pvcaKey.Namespace, Name: pvcaKey.Name},
+				Spec: v1alpha1.PersistentVolumeClaimAutoscalerSpec{
+					VolumePolicies: []v1alpha1.VolumePolicy{{MaxCapacity: resource.MustParse("5Gi")}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, pvca)).To(Succeed())
+			reconcileStatefulSet()
+
+			Expect(fakeClient.Get(ctx, pvcaKey, pvca)).To(Succeed())
+			Expect(pvca.OwnerReferences).To(BeEmpty())
+			Expect(pvca.Spec.VolumePolicies[0].MaxCapacity.Equal(resource.MustParse("5Gi"))).To(BeTrue())
+			Expect(eventRecorder.Events).To(Receive(ContainSubstring(ReasonPVCAConflict)))
+		})
+
+		It("should ignore workloads which do not exist", func() {
+			Expect(fakeClient.Delete(ctx, sts)).To(Succeed())
+			reconcileStatefulSet()
+		})
+	})
+})